@@ -0,0 +1,333 @@
+// Package huffman implements Huffman coding of byte streams.
+//
+// The code tree is built by repeatedly merging the two least frequent subtrees,
+// which are selected with a binary heap. The resulting code lengths are turned into
+// a canonical Huffman code, so the encoded stream only needs to store the length of
+// the code of every symbol in its header.
+//
+// The encoded stream has the following layout:
+//
+//	uvarint  number of bytes in the original data
+//	uvarint  number of symbols in the code table
+//	repeated symbol byte and code length byte, ordered by symbol
+//	bits     the codes of the original bytes, most significant bit first, padded with zeros
+package huffman
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	binaryheap "github.com/GrzegorzMika/data-structures/heap/binary-heap"
+)
+
+// maxCodeLength is the longest code that can be represented.
+// Reaching it requires inputs with Fibonacci-like symbol frequencies and
+// more than 10^13 bytes, so it is never hit in practice.
+const maxCodeLength = 64
+
+// ErrCorrupt is returned by Decode when the encoded stream is malformed.
+var ErrCorrupt = errors.New("huffman: corrupt input")
+
+type node struct {
+	freq   uint64
+	order  int
+	symbol byte
+	left   *node
+	right  *node
+}
+
+// code is the canonical code of a symbol.
+type code struct {
+	bits   uint64
+	length int
+}
+
+// Encode reads all of src, compresses it and writes the result to dst.
+// The whole input is held in memory, because the symbol frequencies must be known
+// before the first code is written.
+func Encode(dst io.Writer, src io.Reader) error {
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return fmt.Errorf("huffman: reading input: %w", err)
+	}
+	var freqs [256]uint64
+	for _, b := range data {
+		freqs[b]++
+	}
+	lengths, err := codeLengths(freqs)
+	if err != nil {
+		return err
+	}
+	codes := canonicalCodes(lengths)
+
+	w := bufio.NewWriter(dst)
+	header := binary.AppendUvarint(nil, uint64(len(data)))
+	symbols := 0
+	for _, l := range lengths {
+		if l > 0 {
+			symbols++
+		}
+	}
+	header = binary.AppendUvarint(header, uint64(symbols))
+	for s, l := range lengths {
+		if l > 0 {
+			header = append(header, byte(s), byte(l))
+		}
+	}
+	if _, err := w.Write(header); err != nil {
+		return fmt.Errorf("huffman: writing header: %w", err)
+	}
+
+	bw := bitWriter{w: w}
+	for _, b := range data {
+		bw.writeBits(codes[b])
+	}
+	bw.flush()
+	if bw.err != nil {
+		return fmt.Errorf("huffman: writing data: %w", bw.err)
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("huffman: writing data: %w", err)
+	}
+	return nil
+}
+
+// Decode reads a stream produced by Encode from src and writes the original data to dst.
+// It returns an error wrapping ErrCorrupt if the stream is malformed.
+func Decode(dst io.Writer, src io.Reader) error {
+	r := bufio.NewReader(src)
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return corrupt("reading length", err)
+	}
+	symbols, err := binary.ReadUvarint(r)
+	if err != nil {
+		return corrupt("reading table size", err)
+	}
+	if symbols > 256 || (symbols == 0 && n > 0) {
+		return fmt.Errorf("%w: invalid table size %d", ErrCorrupt, symbols)
+	}
+	var lengths [256]int
+	for range symbols {
+		var entry [2]byte
+		if _, err := io.ReadFull(r, entry[:]); err != nil {
+			return corrupt("reading table", err)
+		}
+		if entry[1] == 0 || entry[1] > maxCodeLength {
+			return fmt.Errorf("%w: invalid code length %d", ErrCorrupt, entry[1])
+		}
+		lengths[entry[0]] = int(entry[1])
+	}
+	d, err := newDecoder(lengths)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(dst)
+	br := bitReader{r: r}
+	for range n {
+		s, err := d.decode(&br)
+		if err != nil {
+			return err
+		}
+		if err := w.WriteByte(s); err != nil {
+			return fmt.Errorf("huffman: writing output: %w", err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("huffman: writing output: %w", err)
+	}
+	return nil
+}
+
+// codeLengths builds the Huffman tree for the given frequencies and returns the depth of every symbol.
+// Symbols that do not occur get a length of zero.
+func codeLengths(freqs [256]uint64) ([256]int, error) {
+	// the heap keeps the least frequent subtree at the top; ties are broken by creation order
+	// so that the same input always produces the same tree
+	h := binaryheap.NewBinaryHeapFunc(func(a, b *node) int {
+		if c := cmp.Compare(b.freq, a.freq); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.order, a.order)
+	})
+	for s, f := range freqs {
+		if f > 0 {
+			h.Push(&node{freq: f, order: s, symbol: byte(s)})
+		}
+	}
+
+	var lengths [256]int
+	switch h.Len() {
+	case 0:
+		return lengths, nil
+	case 1:
+		// a single symbol still needs a one bit code
+		n, _ := h.Pop()
+		lengths[n.symbol] = 1
+		return lengths, nil
+	}
+	order := 256
+	for h.Len() > 1 {
+		a, _ := h.Pop()
+		b, _ := h.Pop()
+		h.Push(&node{freq: a.freq + b.freq, order: order, left: a, right: b})
+		order++
+	}
+	root, _ := h.Pop()
+
+	var walk func(n *node, depth int)
+	walk = func(n *node, depth int) {
+		if n.left == nil {
+			lengths[n.symbol] = depth
+			return
+		}
+		walk(n.left, depth+1)
+		walk(n.right, depth+1)
+	}
+	walk(root, 0)
+	for _, l := range lengths {
+		if l > maxCodeLength {
+			return lengths, fmt.Errorf("huffman: code length %d exceeds the maximum of %d", l, maxCodeLength)
+		}
+	}
+	return lengths, nil
+}
+
+// canonicalSymbols returns the symbols with non-zero code lengths ordered by length and then by symbol.
+func canonicalSymbols(lengths [256]int) []byte {
+	var symbols []byte
+	for s, l := range lengths {
+		if l > 0 {
+			symbols = append(symbols, byte(s))
+		}
+	}
+	slices.SortStableFunc(symbols, func(a, b byte) int {
+		return cmp.Compare(lengths[a], lengths[b])
+	})
+	return symbols
+}
+
+// canonicalCodes assigns consecutive codes to the symbols in canonical order.
+func canonicalCodes(lengths [256]int) [256]code {
+	var codes [256]code
+	var next uint64
+	prev := 0
+	for _, s := range canonicalSymbols(lengths) {
+		next <<= lengths[s] - prev
+		prev = lengths[s]
+		codes[s] = code{bits: next, length: prev}
+		next++
+	}
+	return codes
+}
+
+// decoder decodes canonical codes bit by bit.
+type decoder struct {
+	symbols []byte
+	// first[l] is the first code of length l, count[l] the number of codes of length l
+	// and offset[l] the index in symbols of the first symbol with a code of length l
+	first  [maxCodeLength + 1]uint64
+	count  [maxCodeLength + 1]uint64
+	offset [maxCodeLength + 1]int
+}
+
+func newDecoder(lengths [256]int) (*decoder, error) {
+	d := &decoder{symbols: canonicalSymbols(lengths)}
+	for _, s := range d.symbols {
+		d.count[lengths[s]]++
+	}
+	var next uint64
+	offset := 0
+	for l := 1; l <= maxCodeLength; l++ {
+		d.first[l] = next
+		d.offset[l] = offset
+		next += d.count[l]
+		offset += int(d.count[l])
+		// the codes of length l must fit in l bits, otherwise the table is not a prefix code
+		if l < maxCodeLength && next > 1<<l {
+			return nil, fmt.Errorf("%w: invalid code table", ErrCorrupt)
+		}
+		next <<= 1
+	}
+	return d, nil
+}
+
+func (d *decoder) decode(br *bitReader) (byte, error) {
+	var c uint64
+	for l := 1; l <= maxCodeLength; l++ {
+		bit, err := br.readBit()
+		if err != nil {
+			return 0, corrupt("reading data", err)
+		}
+		c = c<<1 | uint64(bit)
+		if c-d.first[l] < d.count[l] {
+			return d.symbols[d.offset[l]+int(c-d.first[l])], nil
+		}
+	}
+	return 0, fmt.Errorf("%w: invalid code", ErrCorrupt)
+}
+
+func corrupt(what string, err error) error {
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return fmt.Errorf("%w: %s: %w", ErrCorrupt, what, err)
+}
+
+// bitWriter writes codes most significant bit first.
+type bitWriter struct {
+	w   *bufio.Writer
+	acc byte
+	n   int
+	err error
+}
+
+func (bw *bitWriter) writeBits(c code) {
+	for i := c.length - 1; i >= 0; i-- {
+		bw.acc = bw.acc<<1 | byte(c.bits>>i&1)
+		bw.n++
+		if bw.n == 8 {
+			bw.emit()
+		}
+	}
+}
+
+// flush writes the last partial byte, padded with zeros.
+func (bw *bitWriter) flush() {
+	if bw.n > 0 {
+		bw.acc <<= 8 - bw.n
+		bw.emit()
+	}
+}
+
+func (bw *bitWriter) emit() {
+	if bw.err == nil {
+		bw.err = bw.w.WriteByte(bw.acc)
+	}
+	bw.acc, bw.n = 0, 0
+}
+
+// bitReader reads bits most significant bit first.
+type bitReader struct {
+	r   *bufio.Reader
+	acc byte
+	n   int
+}
+
+func (br *bitReader) readBit() (byte, error) {
+	if br.n == 0 {
+		b, err := br.r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		br.acc, br.n = b, 8
+	}
+	br.n--
+	return br.acc >> br.n & 1, nil
+}
@@ -0,0 +1,162 @@
+package huffman
+
+import (
+	"bytes"
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"testing"
+)
+
+func roundTrip(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var encoded bytes.Buffer
+	if err := Encode(&encoded, bytes.NewReader(data)); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	var decoded bytes.Buffer
+	if err := Decode(&decoded, bytes.NewReader(encoded.Bytes())); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if !bytes.Equal(decoded.Bytes(), data) {
+		t.Fatalf("Decode(Encode(%q)) = %q", data, decoded.Bytes())
+	}
+	return encoded.Bytes()
+}
+
+func TestRoundTrip(t *testing.T) {
+	type testCase struct {
+		name string
+		data []byte
+	}
+
+	all := make([]byte, 256)
+	for i := range all {
+		all[i] = byte(i)
+	}
+	r := rand.New(rand.NewPCG(1, 2))
+	random := make([]byte, 10000)
+	for i := range random {
+		random[i] = byte(r.IntN(256))
+	}
+
+	testCases := []testCase{
+		{name: "empty", data: []byte{}},
+		{name: "single byte", data: []byte("a")},
+		{name: "single symbol", data: []byte("aaaaaaaa")},
+		{name: "two symbols", data: []byte("abababba")},
+		{name: "text", data: []byte("the quick brown fox jumps over the lazy dog")},
+		{name: "all symbols", data: all},
+		{name: "random", data: random},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			roundTrip(t, tc.data)
+		})
+	}
+}
+
+func TestEncodeCompresses(t *testing.T) {
+	data := []byte(strings.Repeat("aaaaaaabbbccd", 1000))
+	encoded := roundTrip(t, data)
+	// the frequencies 7:3:2:1 give codes of 1, 2, 3 and 3 bits, i.e. 22 bits per 13 bytes
+	if want := 13000 * 22 / 8 / 13; len(encoded) > want+16 {
+		t.Errorf("len(Encode()) = %d, want at most %d", len(encoded), want+16)
+	}
+}
+
+func TestCodeLengths(t *testing.T) {
+	var freqs [256]uint64
+	freqs['a'] = 7
+	freqs['b'] = 3
+	freqs['c'] = 2
+	freqs['d'] = 1
+	lengths, err := codeLengths(freqs)
+	if err != nil {
+		t.Fatalf("codeLengths() error = %v", err)
+	}
+	want := map[byte]int{'a': 1, 'b': 2, 'c': 3, 'd': 3}
+	for s, l := range lengths {
+		if l != want[byte(s)] {
+			t.Errorf("length of %q = %d, want %d", byte(s), l, want[byte(s)])
+		}
+	}
+}
+
+func TestCanonicalCodes(t *testing.T) {
+	var lengths [256]int
+	lengths['a'] = 2
+	lengths['b'] = 1
+	lengths['c'] = 3
+	lengths['d'] = 3
+	codes := canonicalCodes(lengths)
+	want := map[byte]code{
+		'b': {bits: 0b0, length: 1},
+		'a': {bits: 0b10, length: 2},
+		'c': {bits: 0b110, length: 3},
+		'd': {bits: 0b111, length: 3},
+	}
+	for s, c := range want {
+		if codes[s] != c {
+			t.Errorf("code of %q = %+v, want %+v", s, codes[s], c)
+		}
+	}
+}
+
+func TestEncodeIsDeterministic(t *testing.T) {
+	data := []byte("abracadabra")
+	var a, b bytes.Buffer
+	if err := Encode(&a, bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	if err := Encode(&b, bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(a.Bytes(), b.Bytes()) {
+		t.Errorf("Encode() produced different outputs for the same input")
+	}
+}
+
+func TestDecodeCorrupt(t *testing.T) {
+	var encoded bytes.Buffer
+	if err := Encode(&encoded, strings.NewReader("abracadabra")); err != nil {
+		t.Fatal(err)
+	}
+	valid := encoded.Bytes()
+
+	type testCase struct {
+		name string
+		data []byte
+	}
+
+	testCases := []testCase{
+		{name: "empty", data: []byte{}},
+		{name: "truncated header", data: valid[:3]},
+		{name: "truncated data", data: valid[:len(valid)-1]},
+		{name: "missing table", data: []byte{5, 0}},
+		{name: "zero code length", data: []byte{1, 1, 'a', 0, 0}},
+		{name: "not a prefix code", data: []byte{1, 3, 'a', 1, 'b', 1, 'c', 1, 0}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var decoded bytes.Buffer
+			err := Decode(&decoded, bytes.NewReader(tc.data))
+			if !errors.Is(err, ErrCorrupt) {
+				t.Errorf("Decode() error = %v, want %v", err, ErrCorrupt)
+			}
+		})
+	}
+}
+
+func BenchmarkEncode(b *testing.B) {
+	data := []byte(strings.Repeat("the quick brown fox jumps over the lazy dog ", 1000))
+	b.SetBytes(int64(len(data)))
+	for range b.N {
+		var encoded bytes.Buffer
+		if err := Encode(&encoded, bytes.NewReader(data)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/GrzegorzMika/data-structures
 
-go 1.22.1
+go 1.23
@@ -0,0 +1,192 @@
+// Package agingqueue implements a priority queue with priority aging.
+//
+// In a plain priority queue, low priority elements can wait forever while higher priority
+// elements keep arriving. An AgingQueue raises the effective priority of every element
+// with the time it has been waiting, according to a pluggable AgingFunc, so that every
+// element is eventually served.
+//
+// Effective priorities drift at different rates, which invalidates the heap order over time.
+// The queue therefore evaluates all effective priorities at a common snapshot time and
+// re-heapifies when the snapshot becomes older than the refresh interval. Pop always returns
+// the element with the highest effective priority as of the latest snapshot.
+package agingqueue
+
+import (
+	"cmp"
+	"time"
+
+	binaryheap "github.com/GrzegorzMika/data-structures/heap/binary-heap"
+)
+
+// AgingFunc computes the effective priority of an element from its base priority
+// and the time it has been waiting in the queue.
+// It should not decrease as waited grows, and it must be deterministic.
+type AgingFunc func(priority float64, waited time.Duration) float64
+
+// Linear returns an AgingFunc that raises the priority by rate for every second of waiting.
+func Linear(rate float64) AgingFunc {
+	return func(priority float64, waited time.Duration) float64 {
+		return priority + rate*waited.Seconds()
+	}
+}
+
+// Clock provides the current time to an AgingQueue.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Option configures an AgingQueue.
+type Option func(*options)
+
+type options struct {
+	clock    Clock
+	interval time.Duration
+}
+
+// WithClock sets the clock used to measure waiting times.
+// By default the system clock is used.
+func WithClock(c Clock) Option {
+	return func(o *options) {
+		o.clock = c
+	}
+}
+
+// WithRefreshInterval sets how old the priority snapshot can get before the queue is re-heapified.
+// A zero interval refreshes the snapshot on every Push, Peek and Pop whenever the clock has moved,
+// which keeps pops exact at the cost of O(n) per operation. By default the interval is one second.
+func WithRefreshInterval(d time.Duration) Option {
+	return func(o *options) {
+		o.interval = d
+	}
+}
+
+type entry[T any] struct {
+	value    T
+	priority float64
+	enqueued time.Time
+	seq      uint64
+}
+
+type AgingQueue[T any] struct {
+	heap     *binaryheap.BinaryHeapFunc[*entry[T]]
+	aging    AgingFunc
+	clock    Clock
+	interval time.Duration
+	snapshot time.Time
+	seq      uint64
+}
+
+// NewAgingQueue creates a new instance of AgingQueue that ages priorities with the given function.
+//
+// Example usage:
+//
+//	q := agingqueue.NewAgingQueue[string](agingqueue.Linear(0.5))
+//	q.Push("report", 1)
+func NewAgingQueue[T any](aging AgingFunc, opts ...Option) *AgingQueue[T] {
+	o := options{
+		clock:    systemClock{},
+		interval: time.Second,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	q := &AgingQueue[T]{
+		aging:    aging,
+		clock:    o.clock,
+		interval: o.interval,
+	}
+	q.snapshot = q.clock.Now()
+	// the heap keeps the highest effective priority at the top; ties go to the oldest element
+	q.heap = binaryheap.NewBinaryHeapFunc(func(a, b *entry[T]) int {
+		if c := cmp.Compare(q.effective(a), q.effective(b)); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.seq, a.seq)
+	})
+	return q
+}
+
+// Len returns the number of elements in the queue.
+//
+// The time complexity of this method is O(1).
+func (q *AgingQueue[T]) Len() int {
+	return q.heap.Len()
+}
+
+// IsEmpty checks if the queue is empty.
+//
+// The time complexity of this method is O(1).
+func (q *AgingQueue[T]) IsEmpty() bool {
+	return q.heap.IsEmpty()
+}
+
+// Push adds an element with the given base priority to the queue.
+// Elements with a higher priority are popped first.
+//
+// The time complexity of this method is O(log n), plus O(n) when the snapshot is refreshed.
+func (q *AgingQueue[T]) Push(x T, priority float64) {
+	q.refreshIfDue()
+	q.seq++
+	q.heap.Push(&entry[T]{
+		value:    x,
+		priority: priority,
+		enqueued: q.clock.Now(),
+		seq:      q.seq,
+	})
+}
+
+// Peek returns the element with the highest effective priority without removing it and true.
+// If the queue is empty, it returns the zero value of type T and false.
+//
+// The time complexity of this method is O(1), plus O(n) when the snapshot is refreshed.
+func (q *AgingQueue[T]) Peek() (T, bool) {
+	q.refreshIfDue()
+	e, ok := q.heap.Peek()
+	if !ok {
+		return *new(T), false
+	}
+	return e.value, true
+}
+
+// Pop removes and returns the element with the highest effective priority and true.
+// If the queue is empty, it returns the zero value of type T and false.
+//
+// The time complexity of this method is O(log n), plus O(n) when the snapshot is refreshed.
+func (q *AgingQueue[T]) Pop() (T, bool) {
+	q.refreshIfDue()
+	e, ok := q.heap.Pop()
+	if !ok {
+		return *new(T), false
+	}
+	return e.value, true
+}
+
+// Refresh re-evaluates the effective priorities at the current time and re-heapifies the queue,
+// regardless of the refresh interval.
+//
+// The time complexity of this method is O(n), where n is the number of elements in the queue.
+func (q *AgingQueue[T]) Refresh() {
+	q.snapshot = q.clock.Now()
+	q.heap.Heapify()
+}
+
+func (q *AgingQueue[T]) refreshIfDue() {
+	now := q.clock.Now()
+	if now.Sub(q.snapshot) < q.interval || !now.After(q.snapshot) {
+		return
+	}
+	q.snapshot = now
+	q.heap.Heapify()
+}
+
+// effective returns the priority of the entry as of the snapshot time.
+// Entries enqueued after the snapshot are treated as not having waited at all.
+func (q *AgingQueue[T]) effective(e *entry[T]) float64 {
+	return q.aging(e.priority, max(q.snapshot.Sub(e.enqueued), 0))
+}
@@ -0,0 +1,179 @@
+package agingqueue
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func TestLinear(t *testing.T) {
+	aging := Linear(2)
+	if got := aging(1, 0); got != 1 {
+		t.Errorf("aging(1, 0) = %v, want 1", got)
+	}
+	if got := aging(1, 1500*time.Millisecond); got != 4 {
+		t.Errorf("aging(1, 1.5s) = %v, want 4", got)
+	}
+}
+
+func TestNewAgingQueue(t *testing.T) {
+	q := NewAgingQueue[string](Linear(1))
+	if q.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", q.Len())
+	}
+	if !q.IsEmpty() {
+		t.Errorf("IsEmpty() = %t, want true", q.IsEmpty())
+	}
+	x, ok := q.Pop()
+	if ok || x != "" {
+		t.Errorf("Pop() = (%q, %t), want (\"\", false)", x, ok)
+	}
+	x, ok = q.Peek()
+	if ok || x != "" {
+		t.Errorf("Peek() = (%q, %t), want (\"\", false)", x, ok)
+	}
+}
+
+func TestAgingQueueWithoutAgingIsPriorityQueue(t *testing.T) {
+	clock := newFakeClock()
+	q := NewAgingQueue[string](Linear(0), WithClock(clock))
+	q.Push("low", 1)
+	q.Push("high", 3)
+	q.Push("mid", 2)
+	q.Push("mid again", 2)
+	var got []string
+	for !q.IsEmpty() {
+		clock.Advance(time.Hour)
+		x, _ := q.Pop()
+		got = append(got, x)
+	}
+	if want := []string{"high", "mid", "mid again", "low"}; !slices.Equal(got, want) {
+		t.Errorf("popped %v, want %v", got, want)
+	}
+}
+
+func TestAgingPreventsStarvation(t *testing.T) {
+	clock := newFakeClock()
+	q := NewAgingQueue[string](Linear(1), WithClock(clock), WithRefreshInterval(0))
+	q.Push("low", 0)
+
+	// a steady stream of high priority jobs arrives faster than they are served
+	var served []string
+	for range 20 {
+		q.Push("high", 5)
+		q.Push("high", 5)
+		clock.Advance(time.Second)
+		x, _ := q.Pop()
+		served = append(served, x)
+		if x == "low" {
+			break
+		}
+	}
+	// the low priority job is only overtaken by the 10 high priority jobs that arrived
+	// less than 5 seconds after it, even though the backlog of high priority jobs keeps growing
+	i := slices.Index(served, "low")
+	if i < 0 {
+		t.Fatalf("low priority job starved, served %v", served)
+	}
+	if i != 10 {
+		t.Errorf("low priority job served after %d pops, want 10", i)
+	}
+}
+
+func TestRefreshInterval(t *testing.T) {
+	clock := newFakeClock()
+	q := NewAgingQueue[string](Linear(1), WithClock(clock), WithRefreshInterval(10*time.Second))
+	q.Push("old", 0)
+	clock.Advance(5 * time.Second)
+	q.Push("new", 3)
+
+	// the snapshot is 5 seconds old, so the old element has not aged yet
+	x, ok := q.Peek()
+	if !ok || x != "new" {
+		t.Errorf("Peek() = (%q, %t), want (\"new\", true)", x, ok)
+	}
+	// once the interval elapses, the old element has waited 10 seconds and the new one 5
+	clock.Advance(5 * time.Second)
+	x, ok = q.Peek()
+	if !ok || x != "old" {
+		t.Errorf("Peek() = (%q, %t), want (\"old\", true)", x, ok)
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	clock := newFakeClock()
+	q := NewAgingQueue[string](Linear(1), WithClock(clock), WithRefreshInterval(time.Hour))
+	q.Push("old", 0)
+	clock.Advance(5 * time.Second)
+	q.Push("new", 3)
+	clock.Advance(time.Second)
+
+	x, _ := q.Peek()
+	if x != "new" {
+		t.Errorf("Peek() = %q before Refresh, want \"new\"", x)
+	}
+	q.Refresh()
+	x, _ = q.Pop()
+	if x != "old" {
+		t.Errorf("Pop() = %q after Refresh, want \"old\"", x)
+	}
+	x, _ = q.Pop()
+	if x != "new" {
+		t.Errorf("Pop() = %q, want \"new\"", x)
+	}
+}
+
+func TestPopsStayOrderedAsPrioritiesDrift(t *testing.T) {
+	clock := newFakeClock()
+	// quadratic aging changes the relative order of elements with different base priorities
+	quadratic := func(priority float64, waited time.Duration) float64 {
+		s := waited.Seconds()
+		return priority + s*s
+	}
+	q := NewAgingQueue[int](quadratic, WithClock(clock), WithRefreshInterval(0))
+	for i := range 50 {
+		q.Push(i, float64((i*37)%50))
+		clock.Advance(100 * time.Millisecond)
+	}
+	// check every pop against a brute force evaluation of the effective priorities
+	type job struct {
+		id       int
+		priority float64
+		enqueued time.Time
+	}
+	var jobs []job
+	start := newFakeClock().now
+	for i := range 50 {
+		jobs = append(jobs, job{i, float64((i * 37) % 50), start.Add(time.Duration(i) * 100 * time.Millisecond)})
+	}
+	for !q.IsEmpty() {
+		clock.Advance(300 * time.Millisecond)
+		x, _ := q.Pop()
+		best := 0
+		for k, j := range jobs {
+			if quadratic(j.priority, clock.now.Sub(j.enqueued)) > quadratic(jobs[best].priority, clock.now.Sub(jobs[best].enqueued)) {
+				best = k
+			}
+		}
+		if jobs[best].id != x {
+			t.Fatalf("Pop() = %d, want %d", x, jobs[best].id)
+		}
+		jobs = slices.Delete(jobs, best, best+1)
+	}
+}
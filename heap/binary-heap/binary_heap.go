@@ -7,23 +7,35 @@ import (
 )
 
 type BinaryHeap[T cmp.Ordered] struct {
-	items []T
+	items    []T
+	observer Observer
+	shrink   ShrinkPolicy
 }
 
 // NewBinaryHeap creates a new instance of BinaryHeap.
 // The binary heap is a complete binary tree where each node is bigger or equal to its children.
 // The elements are stored in an array, and the heap property is maintained with every new element added to the heap.
-func NewBinaryHeap[T cmp.Ordered]() *BinaryHeap[T] {
-	return &BinaryHeap[T]{
-		items: make([]T, 0),
-	}
+//
+// The behaviour of the binary heap can be customised with options, for example WithObserver or WithShrinkPolicy.
+func NewBinaryHeap[T cmp.Ordered](opts ...Option) *BinaryHeap[T] {
+	return newBinaryHeap(make([]T, 0), opts)
 }
 
 // NewBinaryHeapWithCapacity creates a new instance of BinaryHeap with the specified capacity.
 // The capacity is the maximum number of elements that the binary heap can hold without reallocating its underlying slice.
-func NewBinaryHeapWithCapacity[T cmp.Ordered](capacity int) *BinaryHeap[T] {
+func NewBinaryHeapWithCapacity[T cmp.Ordered](capacity int, opts ...Option) *BinaryHeap[T] {
+	return newBinaryHeap(make([]T, 0, capacity), opts)
+}
+
+func newBinaryHeap[T cmp.Ordered](items []T, opts []Option) *BinaryHeap[T] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
 	return &BinaryHeap[T]{
-		items: make([]T, 0, capacity),
+		items:    items,
+		observer: o.observer,
+		shrink:   o.shrink,
 	}
 }
 
@@ -62,6 +74,19 @@ func (bh *BinaryHeap[T]) Clip() {
 	bh.items = slices.Clip(bh.items)
 }
 
+// Reserve grows the capacity of the binary heap, if necessary, to guarantee space for n more elements.
+// After Reserve(n), at least n elements can be pushed to the binary heap without reallocating its underlying slice.
+// It is useful to prepare the binary heap for a burst of elements of a known size.
+//
+// If n is negative, Reserve panics.
+func (bh *BinaryHeap[T]) Reserve(n int) {
+	c := bh.Cap()
+	bh.items = slices.Grow(bh.items, n)
+	if bh.observer != nil && bh.Cap() != c {
+		bh.observer.OnGrow(c, bh.Cap())
+	}
+}
+
 // Push adds one or more elements to the binary heap.
 //
 // The Push method takes a variadic parameter xs, which represents the elements to be added to the heap.
@@ -92,19 +117,46 @@ func (bh *BinaryHeap[T]) Pop() (T, bool) {
 	if bh.Len() == 0 {
 		return *new(T), false
 	}
+	if bh.observer != nil {
+		bh.observer.OnPop()
+	}
 	x := bh.items[0]
 	bh.items[0] = bh.items[bh.Len()-1]
 	bh.items = bh.items[:bh.Len()-1]
 	bh.sinkDown(0)
+	if bh.shrink != nil {
+		bh.shrinkTo(bh.shrink(bh.Len(), bh.Cap()))
+	}
 	return x, true
 }
 
 func (bh *BinaryHeap[T]) push(x T) {
-	n := bh.Len()
+	n, c := bh.Len(), bh.Cap()
 	bh.items = append(bh.items, x)
+	if bh.observer != nil {
+		bh.observer.OnPush()
+		if bh.Cap() != c {
+			bh.observer.OnGrow(c, bh.Cap())
+		}
+	}
 	bh.bubbleUp(n)
 }
 
+// shrinkTo reallocates the underlying slice with the given capacity.
+// It does nothing if the capacity is not smaller than the current one or cannot hold all the elements.
+func (bh *BinaryHeap[T]) shrinkTo(capacity int) {
+	c := bh.Cap()
+	if capacity >= c || capacity < bh.Len() {
+		return
+	}
+	items := make([]T, bh.Len(), capacity)
+	copy(items, bh.items)
+	bh.items = items
+	if bh.observer != nil {
+		bh.observer.OnShrink(c, capacity)
+	}
+}
+
 func (bh *BinaryHeap[T]) parent(i int) int {
 	return (i - 1) / 2
 }
@@ -117,6 +169,10 @@ func (bh *BinaryHeap[T]) right(i int) int {
 }
 
 func (bh *BinaryHeap[T]) bubbleUp(i int) {
+	if bh.observer != nil {
+		bh.bubbleUpObserved(i)
+		return
+	}
 	parentIndex := bh.parent(i)
 	for i > 0 && bh.items[i] >= bh.items[parentIndex] {
 		bh.items[i], bh.items[parentIndex] = bh.items[parentIndex], bh.items[i]
@@ -149,6 +205,10 @@ func (bh *BinaryHeap[T]) singleStepDown(i int) int {
 }
 
 func (bh *BinaryHeap[T]) sinkDown(i int) {
+	if bh.observer != nil {
+		bh.sinkDownObserved(i)
+		return
+	}
 	i = bh.singleStepDown(i)
 	for i >= 0 {
 		i = bh.singleStepDown(i)
@@ -0,0 +1,142 @@
+package binaryheap
+
+import (
+	"slices"
+)
+
+// BinaryHeapFunc is a binary heap ordered by a custom comparison function.
+// It is the counterpart of BinaryHeap for element types that are not cmp.Ordered,
+// in the same way slices.SortFunc is the counterpart of slices.Sort.
+type BinaryHeapFunc[T any] struct {
+	items []T
+	cmp   func(a, b T) int
+}
+
+// NewBinaryHeapFunc creates a new instance of BinaryHeapFunc ordered by the cmp function.
+// The cmp function should return a negative number when a < b, a positive number when a > b
+// and zero when a == b. Like BinaryHeap, the binary heap keeps the biggest element at the top;
+// to get the smallest element first, reverse the arguments of cmp.
+func NewBinaryHeapFunc[T any](cmp func(a, b T) int) *BinaryHeapFunc[T] {
+	return &BinaryHeapFunc[T]{
+		items: make([]T, 0),
+		cmp:   cmp,
+	}
+}
+
+// NewBinaryHeapFuncWithCapacity creates a new instance of BinaryHeapFunc with the specified capacity.
+// The capacity is the maximum number of elements that the binary heap can hold without reallocating its underlying slice.
+func NewBinaryHeapFuncWithCapacity[T any](capacity int, cmp func(a, b T) int) *BinaryHeapFunc[T] {
+	return &BinaryHeapFunc[T]{
+		items: make([]T, 0, capacity),
+		cmp:   cmp,
+	}
+}
+
+// Len returns the number of elements in the binary heap.
+//
+// The time complexity of this method is O(1).
+func (bh *BinaryHeapFunc[T]) Len() int {
+	return len(bh.items)
+}
+
+// IsEmpty checks if the binary heap is empty.
+//
+// It returns true if the binary heap has no elements, and false otherwise.
+//
+// The time complexity of this method is O(1).
+func (bh *BinaryHeapFunc[T]) IsEmpty() bool {
+	return len(bh.items) == 0
+}
+
+// Cap returns the capacity of the binary heap.
+//
+// The capacity is the maximum number of elements that the binary heap can hold
+// without reallocating its underlying slice.
+func (bh *BinaryHeapFunc[T]) Cap() int {
+	return cap(bh.items)
+}
+
+// Clip removes unused capacity from the binary heap.
+//
+// Clip does not change the length of the binary heap; it merely resizes the capacity.
+func (bh *BinaryHeapFunc[T]) Clip() {
+	bh.items = slices.Clip(bh.items)
+}
+
+// Push adds one or more elements to the binary heap.
+//
+// The time complexity of adding each element is O(log n), where n is the number of elements in the heap.
+func (bh *BinaryHeapFunc[T]) Push(xs ...T) {
+	for _, x := range xs {
+		bh.items = append(bh.items, x)
+		bh.bubbleUp(len(bh.items) - 1)
+	}
+}
+
+// Peek returns the biggest element in the binary heap without removing it and true.
+// If the binary heap is empty, it returns a zero value of type T and false.
+//
+// The time complexity of this method is O(1).
+func (bh *BinaryHeapFunc[T]) Peek() (T, bool) {
+	if bh.Len() == 0 {
+		return *new(T), false
+	}
+	return bh.items[0], true
+}
+
+// Pop removes and returns the biggest element from the binary heap.
+// If the binary heap is empty, it returns a zero value of type T and false.
+//
+// The time complexity of this method is O(log n), where n is the number of elements in the heap.
+func (bh *BinaryHeapFunc[T]) Pop() (T, bool) {
+	if bh.Len() == 0 {
+		return *new(T), false
+	}
+	n := bh.Len() - 1
+	x := bh.items[0]
+	bh.items[0] = bh.items[n]
+	// clear the vacated slot so that the garbage collector can reclaim pointers held by T
+	bh.items[n] = *new(T)
+	bh.items = bh.items[:n]
+	bh.sinkDown(0)
+	return x, true
+}
+
+// Heapify restores the heap property after the relative order of the elements has changed,
+// for example when the cmp function depends on state that is updated outside of the binary heap.
+//
+// The time complexity of this method is O(n), where n is the number of elements in the heap.
+func (bh *BinaryHeapFunc[T]) Heapify() {
+	for i := bh.Len()/2 - 1; i >= 0; i-- {
+		bh.sinkDown(i)
+	}
+}
+
+func (bh *BinaryHeapFunc[T]) bubbleUp(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if bh.cmp(bh.items[i], bh.items[parent]) < 0 {
+			return
+		}
+		bh.items[i], bh.items[parent] = bh.items[parent], bh.items[i]
+		i = parent
+	}
+}
+
+func (bh *BinaryHeapFunc[T]) sinkDown(i int) {
+	n := bh.Len()
+	for {
+		j := i
+		if l := 2*i + 1; l < n && bh.cmp(bh.items[l], bh.items[j]) > 0 {
+			j = l
+		}
+		if r := 2*i + 2; r < n && bh.cmp(bh.items[r], bh.items[j]) > 0 {
+			j = r
+		}
+		if j == i {
+			return
+		}
+		bh.items[i], bh.items[j] = bh.items[j], bh.items[i]
+		i = j
+	}
+}
@@ -0,0 +1,140 @@
+package binaryheap
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/GrzegorzMika/data-structures/heap"
+	"github.com/GrzegorzMika/data-structures/heap/heaptest"
+)
+
+var _ heap.Heap[int] = (*BinaryHeapFunc[int])(nil)
+
+func TestNewBinaryHeapFunc(t *testing.T) {
+	bh := NewBinaryHeapFunc(cmp.Compare[int])
+	if bh.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", bh.Len())
+	}
+	if !bh.IsEmpty() {
+		t.Errorf("IsEmpty() = %t, want true", bh.IsEmpty())
+	}
+	if bh.Cap() != 0 {
+		t.Errorf("Cap() = %d, want 0", bh.Cap())
+	}
+	x, ok := bh.Pop()
+	if ok || x != 0 {
+		t.Errorf("Pop() = (%v, %t), want (0, false)", x, ok)
+	}
+	x, ok = bh.Peek()
+	if ok || x != 0 {
+		t.Errorf("Peek() = (%v, %t), want (0, false)", x, ok)
+	}
+}
+
+func TestNewBinaryHeapFuncWithCapacity(t *testing.T) {
+	bh := NewBinaryHeapFuncWithCapacity(10, cmp.Compare[int])
+	if bh.Cap() != 10 {
+		t.Errorf("Cap() = %d, want 10", bh.Cap())
+	}
+	bh.Push(1, 2)
+	bh.Clip()
+	if bh.Cap() != 2 {
+		t.Errorf("Cap() = %d, want 2", bh.Cap())
+	}
+}
+
+func TestBinaryHeapFuncMatchesBinaryHeap(t *testing.T) {
+	elements := []int{50, 71, 46, 78, 98, 54, 13, 67, 21, 3, 100, 91, 13, 54, 31, 28, 33, 30, 52, 68, 31, 71}
+	bh := NewBinaryHeap[int]()
+	bhf := NewBinaryHeapFunc(cmp.Compare[int])
+	bh.Push(elements...)
+	bhf.Push(elements...)
+	for !bh.IsEmpty() {
+		want, _ := bh.Pop()
+		x, ok := bhf.Peek()
+		if !ok || x != want {
+			t.Errorf("Peek() = (%v, %t), want (%d, true)", x, ok, want)
+		}
+		x, ok = bhf.Pop()
+		if !ok || x != want {
+			t.Errorf("Pop() = (%v, %t), want (%d, true)", x, ok, want)
+		}
+	}
+	if !bhf.IsEmpty() {
+		t.Errorf("IsEmpty() = %t, want true", bhf.IsEmpty())
+	}
+}
+
+func TestBinaryHeapFuncReversed(t *testing.T) {
+	type item struct {
+		name     string
+		priority int
+	}
+	elements := []item{{"c", 3}, {"a", 1}, {"e", 5}, {"b", 2}, {"d", 4}}
+	bh := NewBinaryHeapFunc(func(a, b item) int {
+		return cmp.Compare(b.priority, a.priority)
+	})
+	bh.Push(elements...)
+	var got []string
+	for !bh.IsEmpty() {
+		x, _ := bh.Pop()
+		got = append(got, x.name)
+	}
+	if want := []string{"a", "b", "c", "d", "e"}; !slices.Equal(got, want) {
+		t.Errorf("pop order = %v, want %v", got, want)
+	}
+}
+
+func TestBinaryHeapFuncHeapify(t *testing.T) {
+	// the heap orders indices by the values they point to, which change behind its back
+	values := []int{5, 3, 8, 1, 9, 2, 7}
+	bh := NewBinaryHeapFunc(func(a, b int) int {
+		return cmp.Compare(values[a], values[b])
+	})
+	for i := range values {
+		bh.Push(i)
+	}
+	for i := range values {
+		values[i] = -values[i]
+	}
+	bh.Heapify()
+	var got []int
+	for !bh.IsEmpty() {
+		i, _ := bh.Pop()
+		got = append(got, values[i])
+	}
+	if want := []int{-1, -2, -3, -5, -7, -8, -9}; !slices.Equal(got, want) {
+		t.Errorf("pop order = %v, want %v", got, want)
+	}
+}
+
+func TestBinaryHeapFuncConformance(t *testing.T) {
+	heaptest.Run(t, func() heap.Heap[int] {
+		return NewBinaryHeapFunc(cmp.Compare[int])
+	})
+	t.Run("Reversed", func(t *testing.T) {
+		reversed := func(a, b int) int { return cmp.Compare(b, a) }
+		heaptest.RunFunc(t, func() heap.Heap[int] {
+			return NewBinaryHeapFunc(reversed)
+		}, reversed)
+	})
+}
+
+func ExampleBinaryHeapFunc() {
+	// order strings by length, shortest first
+	bh := NewBinaryHeapFunc(func(a, b string) int {
+		return cmp.Compare(len(b), len(a))
+	})
+	bh.Push("banana", "fig", "apple")
+	for !bh.IsEmpty() {
+		v, _ := bh.Pop()
+		fmt.Println(strings.ToUpper(v))
+	}
+	// Output:
+	// FIG
+	// APPLE
+	// BANANA
+}
@@ -5,8 +5,13 @@ import (
 	"log"
 	"slices"
 	"testing"
+
+	"github.com/GrzegorzMika/data-structures/heap"
+	"github.com/GrzegorzMika/data-structures/heap/heaptest"
 )
 
+var _ heap.Heap[int] = (*BinaryHeap[int])(nil)
+
 func TestNewBinaryHeap(t *testing.T) {
 	bh := NewBinaryHeap[int]()
 	if bh.Len() != 0 {
@@ -142,6 +147,12 @@ func TestBinaryHeapMultipleRemove(t *testing.T) {
 	}
 }
 
+func TestBinaryHeapConformance(t *testing.T) {
+	heaptest.Run(t, func() heap.Heap[int] {
+		return NewBinaryHeap[int]()
+	})
+}
+
 func BenchmarkBinaryHeapPush(b *testing.B) {
 	bh := NewBinaryHeapWithCapacity[int](b.N)
 	for i := range b.N {
@@ -152,7 +163,9 @@ func BenchmarkBinaryHeapPush(b *testing.B) {
 func ExampleBinaryHeap() {
 	bh := NewBinaryHeap[int]()
 	bh.Push(17, 50, 32, 93, 8, 9, 69, 4, 26, 19)
-	fmt.Println(bh.Peek())
+	if v, ok := bh.Peek(); ok {
+		fmt.Println(v)
+	}
 	v, ok := bh.Pop()
 	if ok {
 		fmt.Println(v)
@@ -0,0 +1,126 @@
+package binaryheap
+
+import (
+	"cmp"
+	"container/heap"
+)
+
+// AsInterface returns a view of the binary heap that implements heap.Interface,
+// so that the binary heap can be passed to code written against container/heap.
+//
+// The view shares its elements with the binary heap: elements pushed with heap.Push
+// can be popped with Pop and vice versa. Both orderings agree, so heap.Pop returns the
+// biggest element, just like Pop. Operations performed through the view bypass the
+// observer and the shrink policy of the binary heap.
+//
+// Example usage:
+//
+//	bh := binaryheap.NewBinaryHeap[int]()
+//	h := bh.AsInterface()
+//	heap.Push(h, 3)
+//	x := heap.Pop(h).(int)
+func (bh *BinaryHeap[T]) AsInterface() heap.Interface {
+	return &interfaceAdapter[T]{bh: bh}
+}
+
+// interfaceAdapter implements heap.Interface on top of the items of a BinaryHeap.
+// container/heap keeps the element for which Less reports true at the root,
+// so Less reports the bigger element as the lesser one to get a max-heap.
+type interfaceAdapter[T cmp.Ordered] struct {
+	bh *BinaryHeap[T]
+}
+
+func (a *interfaceAdapter[T]) Len() int {
+	return a.bh.Len()
+}
+
+func (a *interfaceAdapter[T]) Less(i, j int) bool {
+	return a.bh.items[i] > a.bh.items[j]
+}
+
+func (a *interfaceAdapter[T]) Swap(i, j int) {
+	a.bh.items[i], a.bh.items[j] = a.bh.items[j], a.bh.items[i]
+}
+
+// Push appends x to the binary heap. It panics if x is not of type T.
+// It is meant to be called by heap.Push only.
+func (a *interfaceAdapter[T]) Push(x any) {
+	a.bh.items = append(a.bh.items, x.(T))
+}
+
+// Pop removes and returns the last element of the binary heap.
+// It is meant to be called by heap.Pop only.
+func (a *interfaceAdapter[T]) Pop() any {
+	n := a.bh.Len() - 1
+	x := a.bh.items[n]
+	a.bh.items = a.bh.items[:n]
+	return x
+}
+
+// InterfaceHeap wraps an existing heap.Interface implementation with the API of BinaryHeap.
+// It pops elements in the order defined by the Less method of the wrapped implementation,
+// that is the element for which Less reports true first.
+type InterfaceHeap[T any] struct {
+	h  heap.Interface
+	at func(i int) T
+}
+
+// FromInterface wraps h, whose Push and Pop methods must accept and return values of type T.
+// The at function must return the element at index i of h; heap.Interface only exposes elements
+// through Pop, so at is what lets Peek read the root without modifying the heap.
+// It must read the current contents of h, for example through the pointer that is passed as h:
+//
+//	pq := &priorityQueue{}
+//	ih := binaryheap.FromInterface[*item](pq, func(i int) *item { return (*pq)[i] })
+//
+// FromInterface calls heap.Init, so h does not need to satisfy the heap invariants beforehand.
+//
+// The time complexity of this function is O(n), where n is h.Len().
+func FromInterface[T any](h heap.Interface, at func(i int) T) *InterfaceHeap[T] {
+	heap.Init(h)
+	return &InterfaceHeap[T]{h: h, at: at}
+}
+
+// Len returns the number of elements in the heap.
+func (ih *InterfaceHeap[T]) Len() int {
+	return ih.h.Len()
+}
+
+// IsEmpty checks if the heap is empty.
+func (ih *InterfaceHeap[T]) IsEmpty() bool {
+	return ih.h.Len() == 0
+}
+
+// Push adds one or more elements to the heap.
+//
+// The time complexity of adding each element is O(log n), where n is the number of elements in the heap.
+func (ih *InterfaceHeap[T]) Push(xs ...T) {
+	for _, x := range xs {
+		heap.Push(ih.h, x)
+	}
+}
+
+// Pop removes and returns the first element of the heap and true.
+// If the heap is empty, it returns a zero value of type T and false.
+//
+// The time complexity of this method is O(log n), where n is the number of elements in the heap.
+func (ih *InterfaceHeap[T]) Pop() (T, bool) {
+	if ih.h.Len() == 0 {
+		return *new(T), false
+	}
+	return heap.Pop(ih.h).(T), true
+}
+
+// Peek returns the first element of the heap without removing it and true.
+// If the heap is empty, it returns a zero value of type T and false.
+//
+// The root of a heap.Interface is the element at index 0, which Peek reads with the at function
+// passed to FromInterface, so it returns the same element as the following Pop.
+//
+// The time complexity of this method is O(1).
+func (ih *InterfaceHeap[T]) Peek() (T, bool) {
+	if ih.h.Len() == 0 {
+		return *new(T), false
+	}
+	return ih.at(0), true
+}
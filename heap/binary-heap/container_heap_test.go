@@ -0,0 +1,168 @@
+package binaryheap
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+	"testing"
+
+	dsheap "github.com/GrzegorzMika/data-structures/heap"
+	"github.com/GrzegorzMika/data-structures/heap/heaptest"
+)
+
+var _ dsheap.Heap[int] = (*InterfaceHeap[int])(nil)
+
+// intHeap is the min-heap of ints from the container/heap documentation.
+type intHeap []int
+
+func (h intHeap) Len() int           { return len(h) }
+func (h intHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h intHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *intHeap) Push(x any)        { *h = append(*h, x.(int)) }
+func (h *intHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// maxIntHeap is a max-heap of ints implemented with container/heap.
+type maxIntHeap struct {
+	intHeap
+}
+
+func (h maxIntHeap) Less(i, j int) bool { return h.intHeap[i] > h.intHeap[j] }
+
+// wrapMaxIntHeap wraps a max-heap of the given ints with FromInterface.
+func wrapMaxIntHeap(xs []int) *InterfaceHeap[int] {
+	h := &maxIntHeap{intHeap: xs}
+	return FromInterface(h, func(i int) int { return h.intHeap[i] })
+}
+
+// task is an element with a priority and an identity, used to check the handling of ties.
+type task struct {
+	priority, id int
+}
+
+// taskHeap is a max-heap of tasks ordered by priority only.
+type taskHeap []task
+
+func (h taskHeap) Len() int           { return len(h) }
+func (h taskHeap) Less(i, j int) bool { return h[i].priority > h[j].priority }
+func (h taskHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *taskHeap) Push(x any)        { *h = append(*h, x.(task)) }
+func (h *taskHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+var containerHeapInputs = [][]int{
+	{},
+	{1},
+	{17, 50, 32, 93, 8, 9, 69, 4, 26, 19, 16, 55, 6},
+	{50, 71, 46, 78, 98, 54, 13, 67, 21, 3, 100, 91, 13, 54, 31, 28, 33, 30, 52, 68, 31, 71},
+}
+
+func TestAsInterface(t *testing.T) {
+	for _, input := range containerHeapInputs {
+		bh := NewBinaryHeap[int]()
+		bh.Push(input...)
+		viaAdapter := NewBinaryHeap[int]()
+		h := viaAdapter.AsInterface()
+		for _, x := range input {
+			heap.Push(h, x)
+		}
+		if viaAdapter.Len() != len(input) {
+			t.Errorf("Len() = %d, want %d", viaAdapter.Len(), len(input))
+		}
+		for range input {
+			want, _ := bh.Pop()
+			if got := heap.Pop(h).(int); got != want {
+				t.Errorf("heap.Pop() = %d, want %d", got, want)
+			}
+		}
+		if h.Len() != 0 {
+			t.Errorf("Len() = %d, want 0", h.Len())
+		}
+	}
+}
+
+func TestAsInterfaceSharesElements(t *testing.T) {
+	bh := NewBinaryHeap[int]()
+	h := bh.AsInterface()
+	bh.Push(5, 1, 9)
+	heap.Push(h, 7)
+	heap.Push(h, 3)
+
+	var got []int
+	for i := 0; bh.Len() > 0; i++ {
+		// alternate between both APIs
+		if i%2 == 0 {
+			x, _ := bh.Pop()
+			got = append(got, x)
+		} else {
+			got = append(got, heap.Pop(h).(int))
+		}
+	}
+	if want := []int{9, 7, 5, 3, 1}; !slices.Equal(got, want) {
+		t.Errorf("popped %v, want %v", got, want)
+	}
+}
+
+func TestFromInterface(t *testing.T) {
+	for _, input := range containerHeapInputs {
+		bh := NewBinaryHeap[int]()
+		bh.Push(input...)
+		// FromInterface establishes the heap invariants of the unordered input
+		ih := wrapMaxIntHeap(slices.Clone(input))
+		if ih.Len() != len(input) {
+			t.Errorf("Len() = %d, want %d", ih.Len(), len(input))
+		}
+		for range input {
+			want, _ := bh.Pop()
+			x, ok := ih.Peek()
+			if !ok || x != want {
+				t.Errorf("Peek() = (%v, %t), want (%d, true)", x, ok, want)
+			}
+			x, ok = ih.Pop()
+			if !ok || x != want {
+				t.Errorf("Pop() = (%v, %t), want (%d, true)", x, ok, want)
+			}
+		}
+		x, ok := ih.Pop()
+		if ok || x != 0 {
+			t.Errorf("Pop() = (%v, %t), want (0, false)", x, ok)
+		}
+	}
+}
+
+func TestFromInterfaceConformance(t *testing.T) {
+	heaptest.Run(t, func() dsheap.Heap[int] {
+		return wrapMaxIntHeap(nil)
+	})
+	t.Run("MinHeap", func(t *testing.T) {
+		heaptest.RunFunc(t, func() dsheap.Heap[int] {
+			h := &intHeap{}
+			return FromInterface(h, func(i int) int { return (*h)[i] })
+		}, func(a, b int) int { return cmp.Compare(b, a) })
+	})
+}
+
+func TestFromInterfacePeekTies(t *testing.T) {
+	h := &taskHeap{{1, 1}, {1, 2}, {1, 3}}
+	ih := FromInterface(h, func(i int) task { return (*h)[i] })
+	for ih.Len() > 0 {
+		first, _ := ih.Peek()
+		// peeking does not modify the heap, so repeated peeks agree with each other and with Pop
+		if again, _ := ih.Peek(); again != first {
+			t.Errorf("Peek() = %v, then %v, want the same task", first, again)
+		}
+		if x, ok := ih.Pop(); !ok || x != first {
+			t.Errorf("Pop() = (%v, %t), want (%v, true) returned by Peek()", x, ok, first)
+		}
+	}
+}
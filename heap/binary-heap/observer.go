@@ -0,0 +1,124 @@
+package binaryheap
+
+// Observer receives notifications about the internal operations of a BinaryHeap.
+// It can be used to profile heaps and to export their activity as metrics.
+//
+// The methods are called synchronously from the heap operation that triggered them,
+// so they should be cheap and must not modify the heap.
+type Observer interface {
+	// OnPush is called once for every element added to the heap.
+	OnPush()
+	// OnPop is called once for every element removed from the heap.
+	OnPop()
+	// OnCompare is called for every comparison of two elements.
+	OnCompare()
+	// OnSwap is called for every swap of two elements.
+	OnSwap()
+	// OnGrow is called when the underlying slice is reallocated to a bigger capacity.
+	OnGrow(oldCap, newCap int)
+	// OnShrink is called when the underlying slice is reallocated to a smaller capacity.
+	OnShrink(oldCap, newCap int)
+}
+
+// Stats is an Observer that counts the operations performed by a binary heap.
+//
+// Example usage:
+//
+//	var stats binaryheap.Stats
+//	bh := binaryheap.NewBinaryHeap[int](binaryheap.WithObserver(&stats))
+//	bh.Push(3, 1, 2)
+//	fmt.Println(stats.Comparisons, stats.Swaps)
+//
+// Stats is not safe for concurrent use, just like the heap it observes.
+type Stats struct {
+	Pushes      int
+	Pops        int
+	Comparisons int
+	Swaps       int
+	Grows       int
+	Shrinks     int
+}
+
+// OnPush increments the number of pushes.
+func (s *Stats) OnPush() {
+	s.Pushes++
+}
+
+// OnPop increments the number of pops.
+func (s *Stats) OnPop() {
+	s.Pops++
+}
+
+// OnCompare increments the number of comparisons.
+func (s *Stats) OnCompare() {
+	s.Comparisons++
+}
+
+// OnSwap increments the number of swaps.
+func (s *Stats) OnSwap() {
+	s.Swaps++
+}
+
+// OnGrow increments the number of reallocations to a bigger capacity.
+func (s *Stats) OnGrow(_, _ int) {
+	s.Grows++
+}
+
+// OnShrink increments the number of reallocations to a smaller capacity.
+func (s *Stats) OnShrink(_, _ int) {
+	s.Shrinks++
+}
+
+// Reset sets all counters to zero.
+func (s *Stats) Reset() {
+	*s = Stats{}
+}
+
+// bubbleUpObserved is the instrumented counterpart of bubbleUp.
+func (bh *BinaryHeap[T]) bubbleUpObserved(i int) {
+	for i > 0 {
+		parentIndex := bh.parent(i)
+		bh.observer.OnCompare()
+		if bh.items[i] < bh.items[parentIndex] {
+			return
+		}
+		bh.observer.OnSwap()
+		bh.items[i], bh.items[parentIndex] = bh.items[parentIndex], bh.items[i]
+		i = parentIndex
+	}
+}
+
+// sinkDownObserved is the instrumented counterpart of sinkDown.
+func (bh *BinaryHeap[T]) sinkDownObserved(i int) {
+	for i >= 0 {
+		i = bh.singleStepDownObserved(i)
+	}
+}
+
+// singleStepDownObserved is the instrumented counterpart of singleStepDown.
+func (bh *BinaryHeap[T]) singleStepDownObserved(i int) int {
+	geq := func(a, b int) bool {
+		bh.observer.OnCompare()
+		return bh.items[a] >= bh.items[b]
+	}
+	j := -1
+	r := bh.right(i)
+	if r < bh.Len() && geq(r, i) {
+		l := bh.left(i)
+		if geq(l, r) {
+			j = l
+		} else {
+			j = r
+		}
+	} else {
+		l := bh.left(i)
+		if l < bh.Len() && geq(l, i) {
+			j = l
+		}
+	}
+	if j >= 0 {
+		bh.observer.OnSwap()
+		bh.items[i], bh.items[j] = bh.items[j], bh.items[i]
+	}
+	return j
+}
@@ -0,0 +1,94 @@
+package binaryheap
+
+import (
+	"testing"
+)
+
+type recordingObserver struct {
+	events []string
+	grows  [][2]int
+}
+
+func (r *recordingObserver) OnPush()    { r.events = append(r.events, "push") }
+func (r *recordingObserver) OnPop()     { r.events = append(r.events, "pop") }
+func (r *recordingObserver) OnCompare() { r.events = append(r.events, "compare") }
+func (r *recordingObserver) OnSwap()    { r.events = append(r.events, "swap") }
+func (r *recordingObserver) OnGrow(oldCap, newCap int) {
+	r.events = append(r.events, "grow")
+	r.grows = append(r.grows, [2]int{oldCap, newCap})
+}
+func (r *recordingObserver) OnShrink(_, _ int) { r.events = append(r.events, "shrink") }
+
+func TestObserverEvents(t *testing.T) {
+	r := &recordingObserver{}
+	bh := NewBinaryHeapWithCapacity[int](1, WithObserver(r))
+	bh.Push(1)
+	bh.Push(2)
+	_, _ = bh.Pop()
+
+	want := []string{
+		// first push fits into the initial capacity and has no parent
+		"push",
+		// second push reallocates and bubbles up past the root
+		"push", "grow", "compare", "swap",
+		// pop moves the last element to the root, which has no children left
+		"pop",
+	}
+	if len(r.events) != len(want) {
+		t.Fatalf("events = %v, want %v", r.events, want)
+	}
+	for i := range want {
+		if r.events[i] != want[i] {
+			t.Errorf("events[%d] = %s, want %s", i, r.events[i], want[i])
+		}
+	}
+	if len(r.grows) != 1 || r.grows[0][0] != 1 || r.grows[0][1] != bh.Cap() {
+		t.Errorf("grows = %v, want [[1 %d]]", r.grows, bh.Cap())
+	}
+}
+
+func TestStats(t *testing.T) {
+	var stats Stats
+	bh := NewBinaryHeapWithCapacity[int](10, WithObserver(&stats))
+	bh.Push(17, 50, 32, 93, 8, 9, 69, 4, 26, 19)
+	if stats.Pushes != 10 {
+		t.Errorf("Pushes = %d, want 10", stats.Pushes)
+	}
+	if stats.Grows != 0 {
+		t.Errorf("Grows = %d, want 0", stats.Grows)
+	}
+	if stats.Comparisons == 0 {
+		t.Errorf("Comparisons = 0, want > 0")
+	}
+	swaps := stats.Swaps
+	for !bh.IsEmpty() {
+		_, _ = bh.Pop()
+	}
+	if stats.Pops != 10 {
+		t.Errorf("Pops = %d, want 10", stats.Pops)
+	}
+	if stats.Swaps <= swaps {
+		t.Errorf("Swaps = %d, want > %d", stats.Swaps, swaps)
+	}
+	_, _ = bh.Pop()
+	if stats.Pops != 10 {
+		t.Errorf("Pops = %d after popping empty heap, want 10", stats.Pops)
+	}
+
+	bh.Push(1)
+	if stats.Grows != 0 {
+		t.Errorf("Grows = %d, want 0", stats.Grows)
+	}
+	stats.Reset()
+	if stats != (Stats{}) {
+		t.Errorf("Reset() left %+v, want zero value", stats)
+	}
+}
+
+func BenchmarkBinaryHeapPushWithStats(b *testing.B) {
+	var stats Stats
+	bh := NewBinaryHeapWithCapacity[int](b.N, WithObserver(&stats))
+	for i := range b.N {
+		bh.Push(i)
+	}
+}
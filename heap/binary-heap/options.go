@@ -0,0 +1,28 @@
+package binaryheap
+
+// Option configures a BinaryHeap at construction time.
+type Option func(*options)
+
+type options struct {
+	observer Observer
+	shrink   ShrinkPolicy
+}
+
+// WithObserver attaches an observer to the binary heap.
+// The observer is notified about every operation performed by the heap.
+//
+// Without an observer the heap only pays for a single nil check per push and pop;
+// the instrumented variants of the sifting loops are used only when an observer is attached.
+func WithObserver(o Observer) Option {
+	return func(opts *options) {
+		opts.observer = o
+	}
+}
+
+// WithShrinkPolicy sets the policy used to release unused capacity after elements are popped.
+// By default the binary heap never shrinks its underlying slice.
+func WithShrinkPolicy(p ShrinkPolicy) Option {
+	return func(opts *options) {
+		opts.shrink = p
+	}
+}
@@ -0,0 +1,23 @@
+package binaryheap
+
+// ShrinkPolicy decides how much capacity a binary heap keeps after an element is popped.
+// It receives the current length and capacity of the binary heap and returns the desired capacity.
+// The binary heap reallocates its underlying slice only if the returned capacity is smaller than
+// the current capacity and still big enough to hold all the elements.
+type ShrinkPolicy func(length, capacity int) int
+
+// HalveBelowQuarter returns a ShrinkPolicy that halves the capacity of the binary heap
+// whenever its length falls below a quarter of the capacity.
+// The capacity is never shrunk below minCapacity.
+//
+// Because the capacity is only halved once the length drops to a quarter of it,
+// alternating pushes and pops around the threshold do not cause repeated reallocations,
+// and the amortized time complexity of Push and Pop stays O(log n).
+func HalveBelowQuarter(minCapacity int) ShrinkPolicy {
+	return func(length, capacity int) int {
+		if length >= capacity/4 || capacity/2 < minCapacity {
+			return capacity
+		}
+		return capacity / 2
+	}
+}
@@ -0,0 +1,105 @@
+package binaryheap
+
+import (
+	"testing"
+)
+
+func TestHalveBelowQuarter(t *testing.T) {
+	type testCase struct {
+		name     string
+		length   int
+		capacity int
+		want     int
+	}
+
+	testCases := []testCase{
+		{name: "empty", length: 0, capacity: 0, want: 0},
+		{name: "above quarter", length: 30, capacity: 100, want: 100},
+		{name: "at quarter", length: 25, capacity: 100, want: 100},
+		{name: "below quarter", length: 24, capacity: 100, want: 50},
+		{name: "below minimum capacity", length: 0, capacity: 30, want: 30},
+		{name: "at minimum capacity", length: 0, capacity: 32, want: 16},
+	}
+
+	policy := HalveBelowQuarter(16)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := policy(tc.length, tc.capacity); got != tc.want {
+				t.Errorf("policy(%d, %d) = %d, want %d", tc.length, tc.capacity, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBinaryHeapNeverShrinksByDefault(t *testing.T) {
+	bh := NewBinaryHeap[int]()
+	for i := range 1000 {
+		bh.Push(i)
+	}
+	c := bh.Cap()
+	for !bh.IsEmpty() {
+		_, _ = bh.Pop()
+	}
+	if bh.Cap() != c {
+		t.Errorf("Cap() = %d, want %d", bh.Cap(), c)
+	}
+}
+
+func TestBinaryHeapShrinkPolicy(t *testing.T) {
+	var stats Stats
+	bh := NewBinaryHeapWithCapacity[int](1024, WithShrinkPolicy(HalveBelowQuarter(8)), WithObserver(&stats))
+	for cycle := range 3 {
+		for i := range 1024 {
+			bh.Push(i)
+		}
+		if bh.Cap() < 1024 {
+			t.Fatalf("cycle %d: Cap() = %d after pushes, want at least 1024", cycle, bh.Cap())
+		}
+		for n := bh.Len(); n > 0; n-- {
+			x, ok := bh.Pop()
+			if !ok || x != n-1 {
+				t.Fatalf("cycle %d: Pop() = (%v, %t), want (%d, true)", cycle, x, ok, n-1)
+			}
+			if bh.Len() < bh.Cap()/4 && bh.Cap()/2 >= 8 {
+				t.Fatalf("cycle %d: Cap() = %d with Len() = %d, want capacity halved", cycle, bh.Cap(), bh.Len())
+			}
+		}
+		if bh.Cap() < 8 || bh.Cap() >= 16 {
+			t.Errorf("cycle %d: Cap() = %d after popping all elements, want between 8 and 15", cycle, bh.Cap())
+		}
+	}
+	if stats.Shrinks == 0 {
+		t.Errorf("Shrinks = 0, want > 0")
+	}
+}
+
+func TestBinaryHeapReserve(t *testing.T) {
+	var stats Stats
+	bh := NewBinaryHeap[int](WithObserver(&stats))
+	bh.Push(1, 2, 3)
+	bh.Reserve(100)
+	if bh.Cap() < 103 {
+		t.Errorf("Cap() = %d, want at least 103", bh.Cap())
+	}
+	if stats.Grows == 0 {
+		t.Errorf("Grows = 0, want > 0")
+	}
+	c, grows := bh.Cap(), stats.Grows
+	for i := range 100 {
+		bh.Push(i)
+	}
+	if bh.Cap() != c {
+		t.Errorf("Cap() = %d, want %d", bh.Cap(), c)
+	}
+	if stats.Grows != grows {
+		t.Errorf("Grows = %d, want %d", stats.Grows, grows)
+	}
+
+	bh.Reserve(0)
+	if bh.Cap() != c {
+		t.Errorf("Cap() = %d after Reserve(0), want %d", bh.Cap(), c)
+	}
+	if bh.Len() != 103 {
+		t.Errorf("Len() = %d, want 103", bh.Len())
+	}
+}
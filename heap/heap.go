@@ -0,0 +1,23 @@
+// Package heap defines the interface shared by the heap implementations in this module.
+//
+// Implementations live in their own packages, for example binaryheap.
+// The heaptest package provides a conformance suite for custom implementations.
+package heap
+
+// Heap is a priority queue that always removes its biggest element first.
+// What "biggest" means is up to the implementation: BinaryHeap uses the natural ordering
+// of its elements, while BinaryHeapFunc uses a comparison function.
+type Heap[T any] interface {
+	// Push adds one or more elements to the heap.
+	Push(xs ...T)
+	// Pop removes and returns the biggest element and true.
+	// If the heap is empty, it returns the zero value of type T and false.
+	Pop() (T, bool)
+	// Peek returns the biggest element without removing it and true.
+	// If the heap is empty, it returns the zero value of type T and false.
+	Peek() (T, bool)
+	// Len returns the number of elements in the heap.
+	Len() int
+	// IsEmpty checks if the heap is empty.
+	IsEmpty() bool
+}
@@ -0,0 +1,189 @@
+// Package heaptest implements a conformance test suite for heap.Heap implementations.
+//
+// Example usage, in a _test.go file of the package implementing the heap:
+//
+//	func TestConformance(t *testing.T) {
+//		heaptest.Run(t, func() heap.Heap[int] {
+//			return NewMyHeap[int]()
+//		})
+//	}
+package heaptest
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/GrzegorzMika/data-structures/heap"
+)
+
+// Run runs the conformance suite against heaps created by newHeap,
+// which must pop the biggest int first according to its natural ordering.
+func Run(t *testing.T, newHeap func() heap.Heap[int]) {
+	RunFunc(t, newHeap, cmp.Compare[int])
+}
+
+// RunFunc runs the conformance suite against heaps created by newHeap,
+// which must pop first the element for which cmp reports the biggest value.
+// Elements that compare equal may be popped in any order.
+//
+// Every subtest calls newHeap to get a fresh, empty heap.
+func RunFunc(t *testing.T, newHeap func() heap.Heap[int], cmp func(a, b int) int) {
+	t.Run("Empty", func(t *testing.T) {
+		testEmpty(t, newHeap())
+	})
+	t.Run("PushPeekPop", func(t *testing.T) {
+		testPushPeekPop(t, newHeap())
+	})
+	t.Run("PopOrder", func(t *testing.T) {
+		testPopOrder(t, newHeap, cmp)
+	})
+	t.Run("Model", func(t *testing.T) {
+		for seed := range uint64(20) {
+			t.Run(fmt.Sprintf("seed=%d", seed), func(t *testing.T) {
+				testModel(t, newHeap(), cmp, seed)
+			})
+		}
+	})
+}
+
+func testEmpty(t *testing.T, h heap.Heap[int]) {
+	if h.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", h.Len())
+	}
+	if !h.IsEmpty() {
+		t.Errorf("IsEmpty() = %t, want true", h.IsEmpty())
+	}
+	x, ok := h.Peek()
+	if ok || x != 0 {
+		t.Errorf("Peek() = (%v, %t), want (0, false)", x, ok)
+	}
+	x, ok = h.Pop()
+	if ok || x != 0 {
+		t.Errorf("Pop() = (%v, %t), want (0, false)", x, ok)
+	}
+	h.Push()
+	if !h.IsEmpty() {
+		t.Errorf("IsEmpty() = %t after Push(), want true", h.IsEmpty())
+	}
+}
+
+func testPushPeekPop(t *testing.T, h heap.Heap[int]) {
+	h.Push(42)
+	if h.Len() != 1 || h.IsEmpty() {
+		t.Errorf("Len(), IsEmpty() = %d, %t, want 1, false", h.Len(), h.IsEmpty())
+	}
+	for range 2 {
+		x, ok := h.Peek()
+		if !ok || x != 42 {
+			t.Errorf("Peek() = (%v, %t), want (42, true)", x, ok)
+		}
+	}
+	if h.Len() != 1 {
+		t.Errorf("Len() = %d after Peek, want 1", h.Len())
+	}
+	x, ok := h.Pop()
+	if !ok || x != 42 {
+		t.Errorf("Pop() = (%v, %t), want (42, true)", x, ok)
+	}
+	if h.Len() != 0 || !h.IsEmpty() {
+		t.Errorf("Len(), IsEmpty() = %d, %t, want 0, true", h.Len(), h.IsEmpty())
+	}
+	x, ok = h.Pop()
+	if ok || x != 0 {
+		t.Errorf("Pop() = (%v, %t), want (0, false)", x, ok)
+	}
+}
+
+func testPopOrder(t *testing.T, newHeap func() heap.Heap[int], cmp func(a, b int) int) {
+	inputs := [][]int{
+		{1},
+		{1, 2},
+		{2, 1},
+		{17, 50, 32, 93, 8, 9, 69, 4, 26, 19, 16, 55, 6},
+		{50, 71, 46, 78, 98, 54, 13, 67, 21, 3, 100, 91, 13, 54, 31, 28, 33, 30, 52, 68, 31, 71},
+		{5, 5, 5, 5, 5},
+		{-3, 0, 3, -1, 1, -2, 2},
+		{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		{10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+	}
+	for _, input := range inputs {
+		h := newHeap()
+		h.Push(input...)
+		if h.Len() != len(input) {
+			t.Errorf("Len() = %d after pushing %v, want %d", h.Len(), input, len(input))
+		}
+		want := sortedDescending(input, cmp)
+		for i := range want {
+			x, ok := h.Pop()
+			if !ok || cmp(x, want[i]) != 0 {
+				t.Errorf("Pop() #%d after pushing %v = (%v, %t), want (%d, true)", i, input, x, ok, want[i])
+			}
+		}
+		if !h.IsEmpty() {
+			t.Errorf("IsEmpty() = %t after popping %v, want true", h.IsEmpty(), input)
+		}
+	}
+}
+
+// testModel performs a random sequence of operations on the heap and on a sorted slice
+// acting as the reference model, and checks that they agree after every operation.
+func testModel(t *testing.T, h heap.Heap[int], cmp func(a, b int) int, seed uint64) {
+	r := rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15))
+	var model []int
+	for op := range 1000 {
+		switch r.IntN(4) {
+		case 0, 1:
+			xs := make([]int, r.IntN(4)+1)
+			for i := range xs {
+				xs[i] = r.IntN(100) - 50
+			}
+			h.Push(xs...)
+			model = append(model, xs...)
+			model = sortedDescending(model, cmp)
+		case 2:
+			x, ok := h.Pop()
+			if len(model) == 0 {
+				if ok {
+					t.Fatalf("op %d: Pop() = (%v, %t) on empty heap, want (0, false)", op, x, ok)
+				}
+				continue
+			}
+			if !ok || cmp(x, model[0]) != 0 {
+				t.Fatalf("op %d: Pop() = (%v, %t), want (%d, true)", op, x, ok, model[0])
+			}
+			i := slices.Index(model, x)
+			if i < 0 {
+				t.Fatalf("op %d: Pop() = %v, which was never pushed", op, x)
+			}
+			model = slices.Delete(model, i, i+1)
+		case 3:
+			x, ok := h.Peek()
+			if len(model) == 0 {
+				if ok {
+					t.Fatalf("op %d: Peek() = (%v, %t) on empty heap, want (0, false)", op, x, ok)
+				}
+				continue
+			}
+			if !ok || cmp(x, model[0]) != 0 {
+				t.Fatalf("op %d: Peek() = (%v, %t), want (%d, true)", op, x, ok, model[0])
+			}
+		}
+		if h.Len() != len(model) {
+			t.Fatalf("op %d: Len() = %d, want %d", op, h.Len(), len(model))
+		}
+		if h.IsEmpty() != (len(model) == 0) {
+			t.Fatalf("op %d: IsEmpty() = %t, want %t", op, h.IsEmpty(), len(model) == 0)
+		}
+	}
+}
+
+func sortedDescending(xs []int, cmp func(a, b int) int) []int {
+	sorted := slices.Clone(xs)
+	slices.SortStableFunc(sorted, func(a, b int) int {
+		return cmp(b, a)
+	})
+	return sorted
+}
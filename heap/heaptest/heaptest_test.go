@@ -0,0 +1,55 @@
+package heaptest
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"github.com/GrzegorzMika/data-structures/heap"
+)
+
+// sliceHeap is a naive heap.Heap that keeps its elements in a sorted slice.
+type sliceHeap struct {
+	items []int
+	cmp   func(a, b int) int
+}
+
+func (h *sliceHeap) Push(xs ...int) {
+	h.items = append(h.items, xs...)
+	slices.SortFunc(h.items, h.cmp)
+}
+
+func (h *sliceHeap) Pop() (int, bool) {
+	if len(h.items) == 0 {
+		return 0, false
+	}
+	x := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return x, true
+}
+
+func (h *sliceHeap) Peek() (int, bool) {
+	if len(h.items) == 0 {
+		return 0, false
+	}
+	return h.items[len(h.items)-1], true
+}
+
+func (h *sliceHeap) Len() int      { return len(h.items) }
+func (h *sliceHeap) IsEmpty() bool { return len(h.items) == 0 }
+
+func TestRun(t *testing.T) {
+	Run(t, func() heap.Heap[int] {
+		return &sliceHeap{cmp: cmp.Compare[int]}
+	})
+}
+
+func TestRunFunc(t *testing.T) {
+	// order by absolute value, so that distinct elements compare equal
+	byAbs := func(a, b int) int {
+		return cmp.Compare(max(a, -a), max(b, -b))
+	}
+	RunFunc(t, func() heap.Heap[int] {
+		return &sliceHeap{cmp: byAbs}
+	}, byAbs)
+}
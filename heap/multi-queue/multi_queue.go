@@ -0,0 +1,190 @@
+// Package multiqueue implements a relaxed concurrent priority queue.
+//
+// A MultiQueue spreads its elements over several independently locked binary heaps.
+// Push inserts into a randomly chosen heap and Pop samples two random heaps and removes
+// the bigger of their tops ("power of two choices"). Because no single lock guards the
+// whole structure, throughput keeps scaling with the number of goroutines, at the price
+// of Pop not always returning the globally biggest element.
+//
+// Relaxation guarantees:
+//
+//   - No element is lost or duplicated: every pushed element is popped exactly once.
+//   - With a single goroutine and a single heap, the queue behaves exactly like BinaryHeap.
+//   - With k shards (the argument of NewMultiQueue), the expected rank of a popped element
+//     (the number of bigger elements still in the queue) is O(k), and the expected worst rank
+//     over a run is O(k*log(k)). With the suggested k = c*GOMAXPROCS shards, where c is the
+//     number of shards per goroutine (NewMultiQueue suggests c = 2), this is O(c*GOMAXPROCS). These bounds follow
+//     from the analysis of MultiQueues by Rihani, Sanders and Dementiev (2015) and are independent
+//     of the queue size.
+//   - Pop returns false only when it observed the queue empty, either through the element
+//     counter or by finding every heap empty under its lock. An element whose Push has
+//     not returned yet may be missed.
+package multiqueue
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+
+	binaryheap "github.com/GrzegorzMika/data-structures/heap/binary-heap"
+)
+
+// shard is a single locked binary heap.
+// It is padded to a cache line to avoid false sharing between neighbouring locks.
+type shard[T cmp.Ordered] struct {
+	mu   sync.Mutex
+	heap *binaryheap.BinaryHeap[T]
+	_    [64]byte
+}
+
+type MultiQueue[T cmp.Ordered] struct {
+	shards []shard[T]
+	length atomic.Int64
+}
+
+// NewMultiQueue creates a new instance of MultiQueue backed by the given number of heaps.
+// A good default is two heaps per goroutine that accesses the queue, for example 2*runtime.GOMAXPROCS(0).
+// More heaps reduce contention but weaken the ordering guarantees.
+//
+// It panics if shards is smaller than 1.
+func NewMultiQueue[T cmp.Ordered](shards int) *MultiQueue[T] {
+	if shards < 1 {
+		panic("multiqueue: number of shards must be positive")
+	}
+	q := &MultiQueue[T]{
+		shards: make([]shard[T], shards),
+	}
+	for i := range q.shards {
+		q.shards[i].heap = binaryheap.NewBinaryHeap[T]()
+	}
+	return q
+}
+
+// Len returns the number of elements in the queue.
+// Under concurrent use the result is only a snapshot and may be stale by the time it is returned.
+//
+// The time complexity of this method is O(1).
+func (q *MultiQueue[T]) Len() int {
+	return int(q.length.Load())
+}
+
+// IsEmpty checks if the queue is empty.
+// Under concurrent use the result is only a snapshot and may be stale by the time it is returned.
+//
+// The time complexity of this method is O(1).
+func (q *MultiQueue[T]) IsEmpty() bool {
+	return q.Len() == 0
+}
+
+// Push adds one or more elements to the queue.
+// Each element is inserted into a randomly chosen heap, skipping heaps that are currently locked.
+//
+// Push is safe for concurrent use.
+// The time complexity of adding each element is O(log n), where n is the number of elements in the chosen heap.
+func (q *MultiQueue[T]) Push(xs ...T) {
+	for _, x := range xs {
+		q.push(x)
+	}
+}
+
+// Pop removes and returns a big element from the queue and true.
+// It samples two random heaps and pops the bigger of their tops, so the returned element
+// is close to, but not necessarily, the biggest element in the queue.
+// If the queue is empty, it returns a zero value of type T and false.
+//
+// Pop is safe for concurrent use.
+// The expected time complexity of this method is O(log n), where n is the number of elements in the chosen heap.
+func (q *MultiQueue[T]) Pop() (T, bool) {
+	for attempt := 0; attempt < 2*len(q.shards); attempt++ {
+		if q.Len() == 0 {
+			return *new(T), false
+		}
+		i, j := q.pickTwo()
+		a, b := &q.shards[i], &q.shards[j]
+		if !a.mu.TryLock() {
+			continue
+		}
+		if a != b && !b.mu.TryLock() {
+			a.mu.Unlock()
+			continue
+		}
+		x, ok := popBigger(a.heap, b.heap)
+		if a != b {
+			b.mu.Unlock()
+		}
+		a.mu.Unlock()
+		if ok {
+			q.length.Add(-1)
+			return x, true
+		}
+	}
+	// sampling keeps failing, either because of contention or because only a few heaps
+	// are non-empty; fall back to a blocking scan that is guaranteed to make progress
+	return q.popScan()
+}
+
+func (q *MultiQueue[T]) push(x T) {
+	for attempt := 0; attempt < len(q.shards); attempt++ {
+		s := &q.shards[rand.IntN(len(q.shards))]
+		if s.mu.TryLock() {
+			q.pushLocked(s, x)
+			return
+		}
+	}
+	s := &q.shards[rand.IntN(len(q.shards))]
+	s.mu.Lock()
+	q.pushLocked(s, x)
+}
+
+// pushLocked pushes x into the locked shard and unlocks it.
+// The counter is incremented before the shard is unlocked, so that a Pop of x, which can only
+// happen afterwards, never decrements it first and Len never goes negative.
+func (q *MultiQueue[T]) pushLocked(s *shard[T], x T) {
+	s.heap.Push(x)
+	q.length.Add(1)
+	s.mu.Unlock()
+}
+
+func (q *MultiQueue[T]) popScan() (T, bool) {
+	start := rand.IntN(len(q.shards))
+	for k := range q.shards {
+		s := &q.shards[(start+k)%len(q.shards)]
+		s.mu.Lock()
+		x, ok := s.heap.Pop()
+		s.mu.Unlock()
+		if ok {
+			q.length.Add(-1)
+			return x, true
+		}
+	}
+	return *new(T), false
+}
+
+func (q *MultiQueue[T]) pickTwo() (int, int) {
+	n := len(q.shards)
+	if n == 1 {
+		return 0, 0
+	}
+	i := rand.IntN(n)
+	j := rand.IntN(n - 1)
+	if j >= i {
+		j++
+	}
+	return i, j
+}
+
+// popBigger pops from whichever of the two heaps has the bigger top.
+// Both heaps must be locked by the caller.
+func popBigger[T cmp.Ordered](a, b *binaryheap.BinaryHeap[T]) (T, bool) {
+	x, okA := a.Peek()
+	y, okB := b.Peek()
+	switch {
+	case okA && (!okB || x >= y):
+		return a.Pop()
+	case okB:
+		return b.Pop()
+	default:
+		return *new(T), false
+	}
+}
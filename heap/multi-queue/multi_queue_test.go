@@ -0,0 +1,256 @@
+package multiqueue
+
+import (
+	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+
+	binaryheap "github.com/GrzegorzMika/data-structures/heap/binary-heap"
+)
+
+func TestNewMultiQueue(t *testing.T) {
+	q := NewMultiQueue[int](4)
+	if q.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", q.Len())
+	}
+	if !q.IsEmpty() {
+		t.Errorf("IsEmpty() = %t, want true", q.IsEmpty())
+	}
+	x, ok := q.Pop()
+	if ok || x != 0 {
+		t.Errorf("Pop() = (%v, %t), want (0, false)", x, ok)
+	}
+}
+
+func TestNewMultiQueuePanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	NewMultiQueue[int](0)
+}
+
+func TestMultiQueueSingleShardIsExact(t *testing.T) {
+	elements := []int{17, 50, 32, 93, 8, 9, 69, 4, 26, 19}
+	q := NewMultiQueue[int](1)
+	q.Push(elements...)
+	if q.Len() != len(elements) {
+		t.Errorf("Len() = %d, want %d", q.Len(), len(elements))
+	}
+	slices.Sort(elements)
+	slices.Reverse(elements)
+	for _, v := range elements {
+		x, ok := q.Pop()
+		if !ok || x != v {
+			t.Errorf("Pop() = (%v, %t), want (%d, true)", x, ok, v)
+		}
+	}
+	if !q.IsEmpty() {
+		t.Errorf("IsEmpty() = %t, want true", q.IsEmpty())
+	}
+}
+
+func TestMultiQueuePopsEveryElement(t *testing.T) {
+	const n = 1000
+	q := NewMultiQueue[int](8)
+	for i := range n {
+		q.Push(i)
+	}
+	seen := make([]bool, n)
+	for range n {
+		x, ok := q.Pop()
+		if !ok {
+			t.Fatalf("Pop() = (%v, %t), want (_, true)", x, ok)
+		}
+		if seen[x] {
+			t.Fatalf("Pop() returned %d twice", x)
+		}
+		seen[x] = true
+	}
+	x, ok := q.Pop()
+	if ok {
+		t.Errorf("Pop() = (%v, %t), want (0, false)", x, ok)
+	}
+}
+
+func TestMultiQueueRankError(t *testing.T) {
+	const (
+		n      = 10000
+		shards = 4
+	)
+	q := NewMultiQueue[int](shards)
+	for i := range n {
+		q.Push(i)
+	}
+	// with all elements distinct, the rank of a popped element is the number of
+	// bigger elements that are still in the queue
+	popped := make([]bool, n)
+	biggest := n - 1
+	total := 0
+	for range n {
+		x, _ := q.Pop()
+		popped[x] = true
+		total += biggest - x
+		for biggest >= 0 && popped[biggest] {
+			biggest--
+		}
+	}
+	// the expected rank is O(shards); leave a generous margin to keep the test stable
+	if mean := float64(total) / n; mean > 10*shards {
+		t.Errorf("mean rank error = %.2f, want at most %d", mean, 10*shards)
+	}
+}
+
+func TestMultiQueueConcurrent(t *testing.T) {
+	const (
+		producers = 8
+		consumers = 8
+		perWorker = 2000
+	)
+	q := NewMultiQueue[int](2 * runtime.GOMAXPROCS(0))
+
+	var wg sync.WaitGroup
+	for p := range producers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWorker {
+				q.Push(p*perWorker + i)
+			}
+		}()
+	}
+
+	results := make(chan []int, consumers)
+	var done sync.WaitGroup
+	remaining := make(chan struct{}, producers*perWorker)
+	for range producers * perWorker {
+		remaining <- struct{}{}
+	}
+	close(remaining)
+	for range consumers {
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			var got []int
+			for range remaining {
+				for {
+					if x, ok := q.Pop(); ok {
+						got = append(got, x)
+						break
+					}
+					runtime.Gosched()
+				}
+			}
+			results <- got
+		}()
+	}
+	wg.Wait()
+	done.Wait()
+	close(results)
+
+	var all []int
+	for got := range results {
+		all = append(all, got...)
+	}
+	slices.Sort(all)
+	if len(all) != producers*perWorker {
+		t.Fatalf("popped %d elements, want %d", len(all), producers*perWorker)
+	}
+	for i, v := range all {
+		if v != i {
+			t.Fatalf("popped elements mismatch at %d: got %d", i, v)
+		}
+	}
+	if !q.IsEmpty() {
+		t.Errorf("IsEmpty() = %t, want true", q.IsEmpty())
+	}
+}
+
+func TestMultiQueueLenNeverNegative(t *testing.T) {
+	q := NewMultiQueue[int](4)
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; ; i++ {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				q.Push(i)
+				q.Pop()
+			}
+		}()
+	}
+	for range 100000 {
+		if n := q.Len(); n < 0 {
+			t.Errorf("Len() = %d, want at least 0", n)
+			break
+		}
+	}
+	close(stop)
+	wg.Wait()
+}
+
+type lockedHeap struct {
+	mu   sync.Mutex
+	heap *binaryheap.BinaryHeap[int]
+}
+
+func (l *lockedHeap) Push(x int) {
+	l.mu.Lock()
+	l.heap.Push(x)
+	l.mu.Unlock()
+}
+
+func (l *lockedHeap) Pop() (int, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.heap.Pop()
+}
+
+func BenchmarkMultiQueue(b *testing.B) {
+	for _, parallelism := range []int{1, 4, 32} {
+		b.Run(fmt.Sprintf("goroutines=%d", parallelism*runtime.GOMAXPROCS(0)), func(b *testing.B) {
+			q := NewMultiQueue[int](2 * runtime.GOMAXPROCS(0))
+			for i := range 1024 {
+				q.Push(i)
+			}
+			b.SetParallelism(parallelism)
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					q.Push(i)
+					q.Pop()
+					i++
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkMutexBinaryHeap(b *testing.B) {
+	for _, parallelism := range []int{1, 4, 32} {
+		b.Run(fmt.Sprintf("goroutines=%d", parallelism*runtime.GOMAXPROCS(0)), func(b *testing.B) {
+			q := &lockedHeap{heap: binaryheap.NewBinaryHeap[int]()}
+			for i := range 1024 {
+				q.Push(i)
+			}
+			b.SetParallelism(parallelism)
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					q.Push(i)
+					q.Pop()
+					i++
+				}
+			})
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Package circularlinkedlist implements the generic circular singly linked list.
+//
+// The list is a ring of nodes with a cursor pointing at the current node.
+// Rotating the ring only moves the cursor, which makes it a good fit for round-robin scheduling.
+package circularlinkedlist
+
+type node[T any] struct {
+	value T
+	next  *node[T]
+}
+
+type CircularLinkedList[T any] struct {
+	current *node[T]
+	// previous is the node preceding the current node; it makes removing the current node O(1)
+	previous *node[T]
+	length   int
+}
+
+// NewCircularLinkedList creates and returns a new instance of an empty circular linked list.
+//
+// Example usage:
+//
+//	ring := circularlinkedlist.NewCircularLinkedList[string]()
+//	ring.InsertAfterCurrent("worker-1")
+func NewCircularLinkedList[T any]() *CircularLinkedList[T] {
+	return &CircularLinkedList[T]{}
+}
+
+// Len returns the number of elements in the ring.
+// The time complexity of this operation is O(1).
+func (l *CircularLinkedList[T]) Len() int {
+	return l.length
+}
+
+// Clear removes all elements from the ring.
+// It breaks the cycle so that the garbage collector can reclaim the nodes.
+// The time complexity of this operation is O(n), where n is the length of the ring.
+func (l *CircularLinkedList[T]) Clear() {
+	// dereference object to allow GC to clean up
+	n := l.current
+	for range l.length {
+		tmp := n.next
+		n.next = nil
+		n = tmp
+	}
+	l.current = nil
+	l.previous = nil
+	l.length = 0
+}
+
+// Current returns the element under the cursor and true.
+// If the ring is empty, it returns the zero value of type T and false.
+// The time complexity of this operation is O(1).
+func (l *CircularLinkedList[T]) Current() (T, bool) {
+	if l.length == 0 {
+		return *new(T), false
+	}
+	return l.current.value, true
+}
+
+// Advance moves the cursor to the next element of the ring.
+// If the ring is empty, it does nothing.
+// The time complexity of this operation is O(1).
+func (l *CircularLinkedList[T]) Advance() {
+	if l.length == 0 {
+		return
+	}
+	l.previous = l.current
+	l.current = l.current.next
+}
+
+// Rotate moves the cursor k elements forward.
+// A negative k moves the cursor backward, which is equivalent to moving it Len()+k elements forward.
+// If the ring is empty, it does nothing.
+// The time complexity of this operation is O(k mod n), where n is the length of the ring.
+func (l *CircularLinkedList[T]) Rotate(k int) {
+	if l.length == 0 {
+		return
+	}
+	k %= l.length
+	if k < 0 {
+		k += l.length
+	}
+	for range k {
+		l.Advance()
+	}
+}
+
+// InsertAfterCurrent inserts a new element right after the cursor.
+// If the ring is empty, the new element becomes the current one.
+// The cursor does not move, so the new element is reached with the next Advance.
+// The time complexity of this operation is O(1).
+func (l *CircularLinkedList[T]) InsertAfterCurrent(x T) {
+	n := &node[T]{value: x}
+	if l.length == 0 {
+		n.next = n
+		l.current = n
+		l.previous = n
+		l.length++
+		return
+	}
+	n.next = l.current.next
+	l.current.next = n
+	// in a ring of one element, the current node used to be its own predecessor
+	if l.previous == l.current {
+		l.previous = n
+	}
+	l.length++
+}
+
+// RemoveCurrent removes and returns the element under the cursor and true.
+// The cursor moves to the element that followed the removed one.
+// If the ring is empty, it returns the zero value of type T and false.
+// The time complexity of this operation is O(1).
+func (l *CircularLinkedList[T]) RemoveCurrent() (T, bool) {
+	if l.length == 0 {
+		return *new(T), false
+	}
+	removed := l.current
+	l.length--
+	if l.length == 0 {
+		l.current = nil
+		l.previous = nil
+	} else {
+		l.previous.next = removed.next
+		l.current = removed.next
+	}
+	removed.next = nil
+	return removed.value, true
+}
+
+// Josephus returns the order in which the elements would be eliminated if, starting from
+// the cursor, every k-th element were removed from the ring until it is empty.
+// The ring itself is left unchanged.
+//
+// It panics if k is smaller than 1.
+// The time complexity of this operation is O(n*k), where n is the length of the ring.
+func (l *CircularLinkedList[T]) Josephus(k int) []T {
+	if k < 1 {
+		panic("circularlinkedlist: josephus step must be positive")
+	}
+	ring := NewCircularLinkedList[T]()
+	n := l.current
+	for range l.length {
+		ring.InsertAfterCurrent(n.value)
+		ring.Advance()
+		n = n.next
+	}
+	// the copy was built behind its cursor, which now points at its last element
+	ring.Advance()
+
+	order := make([]T, 0, l.length)
+	for ring.Len() > 0 {
+		ring.Rotate(k - 1)
+		x, _ := ring.RemoveCurrent()
+		order = append(order, x)
+	}
+	return order
+}
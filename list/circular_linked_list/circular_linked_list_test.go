@@ -0,0 +1,238 @@
+package circularlinkedlist
+
+import (
+	"slices"
+	"testing"
+)
+
+// assertInvariants checks that the ring is closed, has the expected length
+// and that previous precedes current.
+func assertInvariants[T any](t *testing.T, l *CircularLinkedList[T]) {
+	t.Helper()
+	if l.length == 0 {
+		if l.current != nil || l.previous != nil {
+			t.Fatalf("empty ring has current %p and previous %p, want nil", l.current, l.previous)
+		}
+		return
+	}
+	if l.previous.next != l.current {
+		t.Fatalf("previous.next is %p, want current %p", l.previous.next, l.current)
+	}
+	n := 1
+	for node := l.current.next; node != l.current; node = node.next {
+		n++
+		if n > l.length {
+			t.Fatalf("ring is longer than its length %d", l.length)
+		}
+	}
+	if n != l.length {
+		t.Fatalf("ring has %d nodes, want length %d", n, l.length)
+	}
+}
+
+// values returns the elements of the ring starting from the cursor.
+func values[T any](l *CircularLinkedList[T]) []T {
+	var xs []T
+	n := l.current
+	for range l.length {
+		xs = append(xs, n.value)
+		n = n.next
+	}
+	return xs
+}
+
+func newRing(xs ...int) *CircularLinkedList[int] {
+	l := NewCircularLinkedList[int]()
+	for _, x := range xs {
+		l.InsertAfterCurrent(x)
+		l.Advance()
+	}
+	l.Advance()
+	return l
+}
+
+func TestEmptyRing(t *testing.T) {
+	l := NewCircularLinkedList[int]()
+	assertInvariants(t, l)
+	if l.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", l.Len())
+	}
+	x, ok := l.Current()
+	if ok || x != 0 {
+		t.Errorf("Current() = (%v, %t), want (0, false)", x, ok)
+	}
+	x, ok = l.RemoveCurrent()
+	if ok || x != 0 {
+		t.Errorf("RemoveCurrent() = (%v, %t), want (0, false)", x, ok)
+	}
+	l.Advance()
+	l.Rotate(3)
+	l.Rotate(-3)
+	assertInvariants(t, l)
+	if got := l.Josephus(2); len(got) != 0 {
+		t.Errorf("Josephus(2) = %v, want []", got)
+	}
+	l.Clear()
+	assertInvariants(t, l)
+}
+
+func TestSingleElementRing(t *testing.T) {
+	l := NewCircularLinkedList[int]()
+	l.InsertAfterCurrent(1)
+	assertInvariants(t, l)
+
+	for _, rotate := range []func(){l.Advance, func() { l.Rotate(5) }, func() { l.Rotate(-1) }} {
+		rotate()
+		assertInvariants(t, l)
+		x, ok := l.Current()
+		if !ok || x != 1 {
+			t.Errorf("Current() = (%v, %t), want (1, true)", x, ok)
+		}
+	}
+	if got := l.Josephus(3); !slices.Equal(got, []int{1}) {
+		t.Errorf("Josephus(3) = %v, want [1]", got)
+	}
+
+	x, ok := l.RemoveCurrent()
+	if !ok || x != 1 {
+		t.Errorf("RemoveCurrent() = (%v, %t), want (1, true)", x, ok)
+	}
+	assertInvariants(t, l)
+	if l.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", l.Len())
+	}
+}
+
+func TestInsertAfterCurrent(t *testing.T) {
+	l := NewCircularLinkedList[int]()
+	l.InsertAfterCurrent(1)
+	l.InsertAfterCurrent(3)
+	assertInvariants(t, l)
+	l.InsertAfterCurrent(2)
+	assertInvariants(t, l)
+	if got := values(l); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("values = %v, want [1 2 3]", got)
+	}
+	x, _ := l.Current()
+	if x != 1 {
+		t.Errorf("Current() = %d, want 1", x)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	type testCase struct {
+		k    int
+		want []int
+	}
+	for _, tc := range []testCase{
+		{k: 0, want: []int{0, 1, 2, 3, 4}},
+		{k: 1, want: []int{1, 2, 3, 4, 0}},
+		{k: 4, want: []int{4, 0, 1, 2, 3}},
+		{k: 5, want: []int{0, 1, 2, 3, 4}},
+		{k: 12, want: []int{2, 3, 4, 0, 1}},
+		{k: -1, want: []int{4, 0, 1, 2, 3}},
+		{k: -7, want: []int{3, 4, 0, 1, 2}},
+	} {
+		l := newRing(0, 1, 2, 3, 4)
+		l.Rotate(tc.k)
+		assertInvariants(t, l)
+		if got := values(l); !slices.Equal(got, tc.want) {
+			t.Errorf("Rotate(%d) = %v, want %v", tc.k, got, tc.want)
+		}
+	}
+}
+
+func TestRoundRobin(t *testing.T) {
+	l := newRing(1, 2, 3)
+	var got []int
+	for range 7 {
+		x, _ := l.Current()
+		got = append(got, x)
+		l.Advance()
+	}
+	if want := []int{1, 2, 3, 1, 2, 3, 1}; !slices.Equal(got, want) {
+		t.Errorf("round robin = %v, want %v", got, want)
+	}
+}
+
+func TestRemoveCurrent(t *testing.T) {
+	l := newRing(1, 2, 3, 4)
+	l.Advance()
+	x, ok := l.RemoveCurrent()
+	if !ok || x != 2 {
+		t.Errorf("RemoveCurrent() = (%v, %t), want (2, true)", x, ok)
+	}
+	assertInvariants(t, l)
+	if got := values(l); !slices.Equal(got, []int{3, 4, 1}) {
+		t.Errorf("values = %v, want [3 4 1]", got)
+	}
+
+	// removing the element that links back to the start keeps the ring closed
+	l.Rotate(2)
+	x, _ = l.RemoveCurrent()
+	if x != 1 {
+		t.Errorf("RemoveCurrent() = %d, want 1", x)
+	}
+	assertInvariants(t, l)
+	if got := values(l); !slices.Equal(got, []int{3, 4}) {
+		t.Errorf("values = %v, want [3 4]", got)
+	}
+
+	// inserting into the ring after removals keeps previous in sync
+	l.InsertAfterCurrent(5)
+	assertInvariants(t, l)
+	if got := values(l); !slices.Equal(got, []int{3, 5, 4}) {
+		t.Errorf("values = %v, want [3 5 4]", got)
+	}
+}
+
+func TestJosephus(t *testing.T) {
+	type testCase struct {
+		name string
+		ring []int
+		k    int
+		want []int
+	}
+	testCases := []testCase{
+		{name: "every element", ring: []int{1, 2, 3, 4}, k: 1, want: []int{1, 2, 3, 4}},
+		{name: "every second element", ring: []int{1, 2, 3, 4, 5, 6, 7}, k: 2, want: []int{2, 4, 6, 1, 5, 3, 7}},
+		{name: "every third element", ring: []int{1, 2, 3, 4, 5, 6, 7}, k: 3, want: []int{3, 6, 2, 7, 5, 1, 4}},
+		{name: "step longer than ring", ring: []int{1, 2, 3}, k: 5, want: []int{2, 3, 1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := newRing(tc.ring...)
+			if got := l.Josephus(tc.k); !slices.Equal(got, tc.want) {
+				t.Errorf("Josephus(%d) = %v, want %v", tc.k, got, tc.want)
+			}
+			// the ring is left untouched
+			assertInvariants(t, l)
+			if got := values(l); !slices.Equal(got, tc.ring) {
+				t.Errorf("values = %v, want %v", got, tc.ring)
+			}
+		})
+	}
+}
+
+func TestJosephusPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	newRing(1, 2, 3).Josephus(0)
+}
+
+func TestClear(t *testing.T) {
+	l := newRing(1, 2, 3)
+	l.Clear()
+	assertInvariants(t, l)
+	if l.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", l.Len())
+	}
+	l.InsertAfterCurrent(4)
+	assertInvariants(t, l)
+	if x, _ := l.Current(); x != 4 {
+		t.Errorf("Current() = %d, want 4", x)
+	}
+}
@@ -0,0 +1,283 @@
+// Package doublylinkedlist implements the generic doubly linked list.
+package doublylinkedlist
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrIndexOutOfRange is returned by the indexed methods when the index is outside of the list.
+// The returned errors wrap it together with the index and the length of the list.
+var ErrIndexOutOfRange = errors.New("doublylinkedlist: index out of range")
+
+// ErrForeignNode is returned by the node-level methods when the node does not belong to the list.
+var ErrForeignNode = errors.New("doublylinkedlist: node does not belong to the list")
+
+type Node[T any] struct {
+	value T
+	next  *Node[T]
+	prev  *Node[T]
+	// owner identifies the list the node belongs to; it is nil if the node was removed
+	owner *owner
+}
+
+// Value returns the element stored in the node.
+// The time complexity of this operation is O(1).
+func (n *Node[T]) Value() T {
+	return n.value
+}
+
+// Set replaces the element stored in the node with v.
+// The time complexity of this operation is O(1).
+func (n *Node[T]) Set(v T) {
+	n.value = v
+}
+
+// Next returns the next node of the list or nil if the node is the last one
+// or does not belong to a list anymore.
+// The time complexity of this operation is O(1).
+func (n *Node[T]) Next() *Node[T] {
+	if n.owner == nil {
+		return nil
+	}
+	return n.next
+}
+
+// Prev returns the previous node of the list or nil if the node is the first one
+// or does not belong to a list anymore.
+// The time complexity of this operation is O(1).
+func (n *Node[T]) Prev() *Node[T] {
+	if n.owner == nil {
+		return nil
+	}
+	return n.prev
+}
+
+type DoublyLinkedList[T any] struct {
+	head   *Node[T]
+	tail   *Node[T]
+	length int
+	// owner is shared by the nodes of the list; it is created lazily
+	owner *owner
+}
+
+// NewDoublyLinkedList creates and returns a new instance of a doubly linked list.
+// The type parameter T is used to specify the type of elements stored in the list.
+// The function returns a pointer to a new DoublyLinkedList instance.
+//
+// Example usage:
+//
+//	list := doublylinkedlist.NewDoublyLinkedList[int]()
+func NewDoublyLinkedList[T any]() *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{}
+}
+
+// Append moves all elements from the other list to the end of the current list and leaves the other list empty.
+// The nodes of the other list are linked to the tail of the current list rather than copied,
+// so nodes obtained from the other list now belong to the current list.
+// If the other list is empty, this function does nothing.
+//
+// It panics if other is the list itself.
+// Time complexity: O(1), as it only requires linking the tail of the current list to the head of the other list.
+func (l *DoublyLinkedList[T]) Append(other *DoublyLinkedList[T]) {
+	if l == other {
+		panic("doublylinkedlist: cannot append a list to itself")
+	}
+	if other.length == 0 {
+		return
+	}
+	l.handOver(other)
+	if l.length == 0 {
+		l.head = other.head
+	} else {
+		l.tail.next = other.head
+		other.head.prev = l.tail
+	}
+	l.tail = other.tail
+	l.length += other.length
+	other.head, other.tail, other.length = nil, nil, 0
+}
+
+// Len returns the number of elements in the list.
+// The time complexity of this operation is O(1).
+func (l *DoublyLinkedList[T]) Len() int {
+	return l.length
+}
+
+// Clear removes all elements from the list.
+// It iterates through the list, unlinking every node,
+// allowing the garbage collector to reclaim the memory occupied by the nodes.
+// After clearing the list, it sets the head and tail to nil and the length to 0.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func (l *DoublyLinkedList[T]) Clear() {
+	// dereference object to allow GC to clean up
+	for n := l.head; n != nil; {
+		tmp := n.next
+		n.next = nil
+		n.prev = nil
+		n.owner = nil
+		n = tmp
+	}
+	l.length = 0
+	l.head = nil
+	l.tail = nil
+	l.owner = nil
+}
+
+// Contains checks if the list contains a specific element based on the provided equality function.
+// It iterates through the list and compares each element using the provided equality function.
+// If an element is found that satisfies the equality function, it returns true.
+// If no such element is found, it returns false.
+func (l *DoublyLinkedList[T]) Contains(x T, eq func(T, T) bool) bool {
+	for n := l.head; n != nil; n = n.next {
+		if eq(n.value, x) {
+			return true
+		}
+	}
+	return false
+}
+
+// Front returns the first node of the list and a boolean indicating if the list is not empty.
+// If the list is empty, it returns nil and false.
+//
+// The time complexity of this operation is O(1), as it only requires accessing the head pointer.
+func (l *DoublyLinkedList[T]) Front() (*Node[T], bool) {
+	if l.length == 0 {
+		return nil, false
+	}
+	return l.head, true
+}
+
+// Back returns the last node of the list and a boolean indicating if the list is not empty.
+// If the list is empty, it returns nil and false.
+//
+// The time complexity of this operation is O(1), as it only requires accessing the tail pointer.
+func (l *DoublyLinkedList[T]) Back() (*Node[T], bool) {
+	if l.length == 0 {
+		return nil, false
+	}
+	return l.tail, true
+}
+
+// Remove removes and returns the element at the specified index from the list.
+// If the index is out of range, it panics with an error wrapping ErrIndexOutOfRange;
+// use RemoveAt to handle invalid indices without panicking.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func (l *DoublyLinkedList[T]) Remove(at int) T {
+	x, err := l.RemoveAt(at)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// RemoveAt removes and returns the element at the specified index from the list.
+// If the index is out of range, the list is left unchanged and
+// the zero value of type T and an error wrapping ErrIndexOutOfRange are returned.
+// The node is found by traversing the list from whichever end is closer to the index.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func (l *DoublyLinkedList[T]) RemoveAt(at int) (T, error) {
+	if at < 0 || at >= l.length {
+		return *new(T), fmt.Errorf("%w [%d] with length %d", ErrIndexOutOfRange, at, l.length)
+	}
+	var n *Node[T]
+	if at < l.length/2 {
+		n = l.head
+		for range at {
+			n = n.next
+		}
+	} else {
+		n = l.tail
+		for i := l.length - 1; i > at; i-- {
+			n = n.prev
+		}
+	}
+	return l.unlink(n), nil
+}
+
+// RemoveNode removes the given node from the list and returns its value.
+// If the node does not belong to the list, the list is left unchanged and
+// the zero value of type T and ErrForeignNode are returned.
+// The time complexity of this operation is O(1), as the neighbours of the node are known.
+func (l *DoublyLinkedList[T]) RemoveNode(n *Node[T]) (T, error) {
+	if !l.owns(n) {
+		return *new(T), ErrForeignNode
+	}
+	return l.unlink(n), nil
+}
+
+// PopFront removes and returns the first element from the list.
+// If the list is empty, it returns the zero value of type T and false.
+// The time complexity of this operation is O(1), as it only requires updating the head pointer.
+func (l *DoublyLinkedList[T]) PopFront() (T, bool) {
+	if l.length == 0 {
+		return *new(T), false
+	}
+	return l.unlink(l.head), true
+}
+
+// PopBack removes and returns the last element from the list.
+// If the list is empty, it returns the zero value of type T and false.
+// The time complexity of this operation is O(1), as it only requires updating the tail pointer.
+func (l *DoublyLinkedList[T]) PopBack() (T, bool) {
+	if l.length == 0 {
+		return *new(T), false
+	}
+	return l.unlink(l.tail), true
+}
+
+// PushFront adds a new node with the given value to the front of the list and returns it.
+// The time complexity of this operation is O(1), as it only requires updating the head pointer.
+func (l *DoublyLinkedList[T]) PushFront(x T) *Node[T] {
+	node := &Node[T]{
+		value: x,
+		next:  l.head,
+		owner: l.token(),
+	}
+	if l.length == 0 {
+		l.tail = node
+	} else {
+		l.head.prev = node
+	}
+	l.head = node
+	l.length++
+	return node
+}
+
+// PushBack adds a new node with the given value to the end of the list and returns it.
+// The time complexity of this operation is O(1), as it only requires updating the tail pointer.
+func (l *DoublyLinkedList[T]) PushBack(x T) *Node[T] {
+	node := &Node[T]{
+		value: x,
+		prev:  l.tail,
+		owner: l.token(),
+	}
+	if l.length == 0 {
+		l.head = node
+	} else {
+		l.tail.next = node
+	}
+	l.tail = node
+	l.length++
+	return node
+}
+
+// unlink removes the node, which must belong to the list, and returns its value.
+func (l *DoublyLinkedList[T]) unlink(n *Node[T]) T {
+	if n.prev == nil {
+		l.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		l.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+	// dereference neighbours to allow GC to clean up and to detach the node from the list
+	n.next = nil
+	n.prev = nil
+	n.owner = nil
+	l.length--
+	return n.value
+}
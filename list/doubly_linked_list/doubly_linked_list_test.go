@@ -0,0 +1,452 @@
+package doublylinkedlist
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// assertInvariants checks that the links, head, tail and length of the list are consistent.
+func assertInvariants[T any](t *testing.T, l *DoublyLinkedList[T]) {
+	t.Helper()
+	if l.length == 0 {
+		if l.head != nil || l.tail != nil {
+			t.Fatalf("empty list has head %p and tail %p, want nil", l.head, l.tail)
+		}
+		return
+	}
+	if l.head.prev != nil {
+		t.Fatalf("head.prev is %p, want nil", l.head.prev)
+	}
+	n := 0
+	var prev *Node[T]
+	for node := l.head; node != nil; node = node.next {
+		if node.prev != prev {
+			t.Fatalf("node %d has prev %p, want %p", n, node.prev, prev)
+		}
+		if !l.owns(node) {
+			t.Fatalf("node %d does not belong to the list", n)
+		}
+		prev = node
+		n++
+	}
+	if n != l.length {
+		t.Fatalf("list has %d nodes, want length %d", n, l.length)
+	}
+	if l.tail != prev {
+		t.Fatalf("tail is %p, want last node %p", l.tail, prev)
+	}
+}
+
+func values[T any](l *DoublyLinkedList[T]) []T {
+	var xs []T
+	for n, _ := l.Front(); n != nil; n = n.Next() {
+		xs = append(xs, n.Value())
+	}
+	return xs
+}
+
+func TestNewDoublyLinkedList(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+
+	if l.head != nil {
+		t.Errorf("expected head to be nil, got %#v", l.head)
+	}
+	if l.Len() != 0 {
+		t.Errorf("expected length to be 0, got %d", l.length)
+	}
+	assertInvariants(t, l)
+}
+
+func TestPushFront(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+	n1 := l.PushFront(1)
+	n2 := l.PushFront(2)
+	assertInvariants(t, l)
+
+	if l.Len() != 2 {
+		t.Errorf("expected length to be 2, got %d", l.length)
+	}
+	if n1.Value() != 1 || n2.Value() != 2 {
+		t.Errorf("PushFront() returned nodes with %d and %d, want 1 and 2", n1.Value(), n2.Value())
+	}
+	if got := values(l); !slices.Equal(got, []int{2, 1}) {
+		t.Errorf("values = %v, want [2 1]", got)
+	}
+}
+
+func TestPushBack(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+	l.PushBack(1)
+	l.PushBack(2)
+	assertInvariants(t, l)
+
+	if l.Len() != 2 {
+		t.Errorf("expected length to be 2, got %d", l.length)
+	}
+	if got := values(l); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("values = %v, want [1 2]", got)
+	}
+}
+
+func TestPopFront(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+
+	x, ok := l.PopFront()
+	if ok || x != 0 {
+		t.Errorf("PopFront() = (%v, %t), want (0, false)", x, ok)
+	}
+
+	l.PushBack(1)
+	l.PushBack(2)
+
+	x, ok = l.PopFront()
+	if !ok || x != 1 {
+		t.Errorf("PopFront() = (%v, %t), want (1, true)", x, ok)
+	}
+	assertInvariants(t, l)
+	x, ok = l.PopFront()
+	if !ok || x != 2 {
+		t.Errorf("PopFront() = (%v, %t), want (2, true)", x, ok)
+	}
+	assertInvariants(t, l)
+	x, ok = l.PopFront()
+	if ok || x != 0 {
+		t.Errorf("PopFront() = (%v, %t), want (0, false)", x, ok)
+	}
+}
+
+func TestPopBack(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+
+	x, ok := l.PopBack()
+	if ok || x != 0 {
+		t.Errorf("PopBack() = (%v, %t), want (0, false)", x, ok)
+	}
+
+	l.PushBack(1)
+	l.PushBack(2)
+
+	x, ok = l.PopBack()
+	if !ok || x != 2 {
+		t.Errorf("PopBack() = (%v, %t), want (2, true)", x, ok)
+	}
+	assertInvariants(t, l)
+	x, ok = l.PopBack()
+	if !ok || x != 1 {
+		t.Errorf("PopBack() = (%v, %t), want (1, true)", x, ok)
+	}
+	assertInvariants(t, l)
+	x, ok = l.PopBack()
+	if ok || x != 0 {
+		t.Errorf("PopBack() = (%v, %t), want (0, false)", x, ok)
+	}
+}
+
+func TestContains(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+	cmp := func(x1, x2 int) bool { return x1 == x2 }
+
+	if l.Contains(1, cmp) {
+		t.Errorf("Contains(1) = true, want false")
+	}
+
+	l.PushBack(1)
+	l.PushBack(2)
+
+	if !l.Contains(1, cmp) {
+		t.Errorf("Contains(1) = false, want true")
+	}
+	if !l.Contains(2, cmp) {
+		t.Errorf("Contains(2) = false, want true")
+	}
+	if l.Contains(3, cmp) {
+		t.Errorf("Contains(3) = true, want false")
+	}
+}
+
+func TestFrontBack(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+
+	x, ok := l.Front()
+	if ok || x != nil {
+		t.Errorf("Front() = (%v, %t), want (nil, false)", x, ok)
+	}
+	x, ok = l.Back()
+	if ok || x != nil {
+		t.Errorf("Back() = (%v, %t), want (nil, false)", x, ok)
+	}
+
+	l.PushBack(1)
+	l.PushBack(2)
+
+	x, ok = l.Front()
+	if !ok || x.Value() != 1 {
+		t.Errorf("Front() = (%v, %t), want (1, true)", x.Value(), ok)
+	}
+	x, ok = l.Back()
+	if !ok || x.Value() != 2 {
+		t.Errorf("Back() = (%v, %t), want (2, true)", x.Value(), ok)
+	}
+}
+
+func TestNavigation(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+	for i := range 5 {
+		l.PushBack(i)
+	}
+	var backwards []int
+	for n, _ := l.Back(); n != nil; n = n.Prev() {
+		backwards = append(backwards, n.Value())
+	}
+	if want := []int{4, 3, 2, 1, 0}; !slices.Equal(backwards, want) {
+		t.Errorf("backward traversal = %v, want %v", backwards, want)
+	}
+	front, _ := l.Front()
+	if front.Prev() != nil {
+		t.Errorf("Front().Prev() = %v, want nil", front.Prev())
+	}
+	back, _ := l.Back()
+	if back.Next() != nil {
+		t.Errorf("Back().Next() = %v, want nil", back.Next())
+	}
+}
+
+func TestClear(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+	n := l.PushBack(1)
+	l.PushBack(2)
+
+	l.Clear()
+	assertInvariants(t, l)
+	if l.Len() != 0 {
+		t.Errorf("expected length to be 0, got %d", l.length)
+	}
+	if n.Next() != nil || n.Prev() != nil {
+		t.Errorf("cleared node still links to other nodes")
+	}
+	if _, err := l.RemoveNode(n); !errors.Is(err, ErrForeignNode) {
+		t.Errorf("RemoveNode() of a cleared node = %v, want %v", err, ErrForeignNode)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+	for i := 1; i <= 5; i++ {
+		l.PushBack(i)
+	}
+
+	type testCase struct {
+		at   int
+		want int
+		left []int
+	}
+	for _, tc := range []testCase{
+		{at: 3, want: 4, left: []int{1, 2, 3, 5}},
+		{at: 0, want: 1, left: []int{2, 3, 5}},
+		{at: 2, want: 5, left: []int{2, 3}},
+		{at: 1, want: 3, left: []int{2}},
+		{at: 0, want: 2, left: nil},
+	} {
+		if x := l.Remove(tc.at); x != tc.want {
+			t.Errorf("Remove(%d) = %d, want %d", tc.at, x, tc.want)
+		}
+		assertInvariants(t, l)
+		if got := values(l); !slices.Equal(got, tc.left) {
+			t.Errorf("values after Remove(%d) = %v, want %v", tc.at, got, tc.left)
+		}
+	}
+}
+
+func TestRemovePanic(t *testing.T) {
+	for _, at := range []int{-1, 3} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Remove(%d) did not panic", at)
+				}
+			}()
+
+			l := NewDoublyLinkedList[int]()
+			l.PushBack(1)
+			l.PushBack(2)
+			l.PushBack(3)
+
+			l.Remove(at)
+		}()
+	}
+}
+
+func TestRemoveAt(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+	l.PushBack(1)
+	l.PushBack(2)
+	l.PushBack(3)
+	for _, at := range []int{-1, 3} {
+		x, err := l.RemoveAt(at)
+		if !errors.Is(err, ErrIndexOutOfRange) || x != 0 {
+			t.Errorf("RemoveAt(%d) = (%v, %v), want (0, %v)", at, x, err, ErrIndexOutOfRange)
+		}
+	}
+	if _, err := l.RemoveAt(3); err == nil || err.Error() != "doublylinkedlist: index out of range [3] with length 3" {
+		t.Errorf("RemoveAt(3) error = %v, want index and length in the message", err)
+	}
+	assertInvariants(t, l)
+
+	x, err := l.RemoveAt(1)
+	if err != nil || x != 2 {
+		t.Errorf("RemoveAt(1) = (%v, %v), want (2, nil)", x, err)
+	}
+	assertInvariants(t, l)
+	if got := values(l); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("values = %v, want [1 3]", got)
+	}
+
+	defer func() {
+		r := recover()
+		if err, ok := r.(error); !ok || !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("Remove(Len()) panicked with %v, want %v", r, ErrIndexOutOfRange)
+		}
+	}()
+	l.Remove(l.Len())
+}
+
+func TestNodeValue(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+	n := l.PushBack(1)
+	if n.Value() != 1 {
+		t.Errorf("Value() = %d, want 1", n.Value())
+	}
+	n.Set(10)
+	if x, _ := l.PopFront(); x != 10 {
+		t.Errorf("PopFront() after Set(10) = %d, want 10", x)
+	}
+}
+
+func TestPushAllocs(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+	// the element is stored in the node, so a push allocates only the node
+	if allocs := testing.AllocsPerRun(100, func() { l.PushBack(1) }); allocs != 1 {
+		t.Errorf("PushBack() allocates %v times, want 1", allocs)
+	}
+}
+
+func TestRemoveNode(t *testing.T) {
+	l := NewDoublyLinkedList[int]()
+	nodes := make([]*Node[int], 5)
+	for i := range nodes {
+		nodes[i] = l.PushBack(i)
+	}
+
+	for _, i := range []int{2, 0, 4} {
+		x, err := l.RemoveNode(nodes[i])
+		if err != nil || x != i {
+			t.Errorf("RemoveNode(%d) = (%v, %v), want (%d, nil)", i, x, err, i)
+		}
+		assertInvariants(t, l)
+	}
+	if got := values(l); !slices.Equal(got, []int{1, 3}) {
+		t.Errorf("values = %v, want [1 3]", got)
+	}
+
+	// removing a node twice or a node of another list does nothing
+	if _, err := l.RemoveNode(nodes[2]); !errors.Is(err, ErrForeignNode) {
+		t.Errorf("RemoveNode() of a removed node = %v, want %v", err, ErrForeignNode)
+	}
+	other := NewDoublyLinkedList[int]()
+	foreign := other.PushBack(9)
+	if _, err := l.RemoveNode(foreign); !errors.Is(err, ErrForeignNode) {
+		t.Errorf("RemoveNode() of a foreign node = %v, want %v", err, ErrForeignNode)
+	}
+	if _, err := l.RemoveNode(nil); !errors.Is(err, ErrForeignNode) {
+		t.Errorf("RemoveNode(nil) = %v, want %v", err, ErrForeignNode)
+	}
+	assertInvariants(t, l)
+	assertInvariants(t, other)
+	if l.Len() != 2 || other.Len() != 1 {
+		t.Errorf("lengths = %d and %d, want 2 and 1", l.Len(), other.Len())
+	}
+}
+
+func TestAppend(t *testing.T) {
+	type testCase struct {
+		name  string
+		left  []int
+		right []int
+	}
+
+	testCases := []testCase{
+		{name: "empty", left: nil, right: nil},
+		{name: "append empty to non-empty", left: []int{1}, right: nil},
+		{name: "append non-empty to empty", left: nil, right: []int{1}},
+		{name: "append non-empty to non-empty", left: []int{1, 2}, right: []int{3, 4}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l1 := NewDoublyLinkedList[int]()
+			l2 := NewDoublyLinkedList[int]()
+			for _, x := range tc.left {
+				l1.PushBack(x)
+			}
+			for _, x := range tc.right {
+				l2.PushBack(x)
+			}
+			l1.Append(l2)
+			assertInvariants(t, l1)
+			assertInvariants(t, l2)
+			if got, want := values(l1), slices.Concat(tc.left, tc.right); !slices.Equal(got, want) {
+				t.Errorf("values = %v, want %v", got, want)
+			}
+			if l2.Len() != 0 {
+				t.Errorf("other list has length %d after Append(), want 0", l2.Len())
+			}
+		})
+	}
+
+	t.Run("nodes are moved", func(t *testing.T) {
+		l1 := NewDoublyLinkedList[int]()
+		l2 := NewDoublyLinkedList[int]()
+		l1.PushBack(1)
+		n := l2.PushBack(2)
+		l1.Append(l2)
+		if n.Prev() == nil || n.Prev().Value() != 1 {
+			t.Errorf("moved node is not linked after the tail of the list")
+		}
+		if _, err := l2.RemoveNode(n); !errors.Is(err, ErrForeignNode) {
+			t.Errorf("RemoveNode() on the other list = %v, want %v", err, ErrForeignNode)
+		}
+		if x, err := l1.RemoveNode(n); err != nil || x != 2 {
+			t.Errorf("RemoveNode() = (%v, %v), want (2, nil)", x, err)
+		}
+		// the emptied list is usable again and does not own the moved nodes
+		l2.PushBack(3)
+		l1.Append(l2)
+		assertInvariants(t, l1)
+		if got := values(l1); !slices.Equal(got, []int{1, 3}) {
+			t.Errorf("values = %v, want [1 3]", got)
+		}
+	})
+
+	t.Run("append to itself", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("The code did not panic")
+			}
+		}()
+		l := NewDoublyLinkedList[int]()
+		l.PushBack(1)
+		l.Append(l)
+	})
+}
+
+func BenchmarkPopBack(b *testing.B) {
+	l := NewDoublyLinkedList[int]()
+	for i := range b.N {
+		l.PushBack(i)
+	}
+	b.ResetTimer()
+	for range b.N {
+		l.PopBack()
+	}
+}
@@ -0,0 +1,46 @@
+package doublylinkedlist
+
+// owner identifies the list a node belongs to. Nodes point at an owner rather than at the list itself,
+// so that all nodes of one list can be handed over to another list in O(1): the owner of the giving list
+// is linked to the owner of the receiving one, as in a disjoint-set forest. The owner of a list is always
+// the root of its tree, and a node belongs to a list if the root of the node's owner is the list's owner.
+type owner struct {
+	// parent is the owner this one was merged into, or nil if it is a root
+	parent *owner
+}
+
+// root returns the root of the tree of owners o belongs to.
+// It compresses the path on the way, which keeps the amortized cost of lookups low.
+func (o *owner) root() *owner {
+	root := o
+	for root.parent != nil {
+		root = root.parent
+	}
+	for o != root {
+		o.parent, o = root, o.parent
+	}
+	return root
+}
+
+// token returns the owner of the list, creating it on first use so that the zero value of the list is usable.
+func (l *DoublyLinkedList[T]) token() *owner {
+	if l.owner == nil {
+		l.owner = &owner{}
+	}
+	return l.owner
+}
+
+// owns reports whether the node belongs to the list.
+func (l *DoublyLinkedList[T]) owns(n *Node[T]) bool {
+	return n != nil && n.owner != nil && l.owner != nil && n.owner.root() == l.owner
+}
+
+// handOver makes all nodes of the other list belong to l without visiting them.
+// The other list gets a new owner on its next use, so it must be emptied by the caller.
+func (l *DoublyLinkedList[T]) handOver(other *DoublyLinkedList[T]) {
+	if other.owner == nil {
+		return
+	}
+	other.owner.parent = l.token()
+	other.owner = nil
+}
@@ -0,0 +1,111 @@
+// Package lockfreequeue implements the Michael-Scott lock-free FIFO queue.
+//
+// The queue is a singly linked list of nodes with a dummy node at its front. Producers
+// append nodes at the tail and consumers advance the head with compare-and-swap operations
+// on atomic pointers, so any number of goroutines can use the queue concurrently without
+// a lock. A goroutine that finds the tail lagging behind helps to advance it before retrying,
+// which guarantees that some operation always makes progress.
+//
+// Guarantees:
+//
+//   - Every operation is linearizable: the queue behaves as if each Enqueue and TryDequeue
+//     took effect atomically at some point between its call and its return.
+//   - As a consequence, the elements enqueued by a single goroutine are dequeued in the
+//     order in which they were enqueued.
+//   - The ABA problem of the original algorithm does not arise, as nodes are never reused;
+//     the garbage collector reclaims them once no goroutine can reach them.
+package lockfreequeue
+
+import "sync/atomic"
+
+type node[T any] struct {
+	value T
+	next  atomic.Pointer[node[T]]
+}
+
+type LockFreeQueue[T any] struct {
+	// head points at the dummy node; the first element is stored in the node after it.
+	// The dummy node is the last dequeued one, so its value is kept alive until the next TryDequeue.
+	head atomic.Pointer[node[T]]
+	// tail points at the last node or, while an Enqueue is in progress, at the one before it
+	tail   atomic.Pointer[node[T]]
+	length atomic.Int64
+}
+
+// NewLockFreeQueue creates and returns a new instance of an empty lock-free queue.
+//
+// Example usage:
+//
+//	q := lockfreequeue.NewLockFreeQueue[int]()
+//	q.Enqueue(1)
+//	x, ok := q.TryDequeue()
+func NewLockFreeQueue[T any]() *LockFreeQueue[T] {
+	q := &LockFreeQueue[T]{}
+	dummy := &node[T]{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+// Enqueue adds x to the end of the queue.
+// It is safe to call Enqueue concurrently with any other method.
+// The time complexity of this operation is O(1) in the absence of contention.
+func (q *LockFreeQueue[T]) Enqueue(x T) {
+	n := &node[T]{value: x}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			// another Enqueue linked its node but has not advanced the tail yet; help it
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, n) {
+			// failing is fine: it means another goroutine has already advanced the tail
+			q.tail.CompareAndSwap(tail, n)
+			q.length.Add(1)
+			return
+		}
+	}
+}
+
+// TryDequeue removes and returns the first element of the queue and true.
+// If the queue is empty, it returns the zero value of type T and false without blocking.
+// It is safe to call TryDequeue concurrently with any other method.
+// The time complexity of this operation is O(1) in the absence of contention.
+func (q *LockFreeQueue[T]) TryDequeue() (T, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			return *new(T), false
+		}
+		if head == tail {
+			// the queue is not empty, but the tail lags behind; help the Enqueue in progress
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		// read the value before the node becomes the dummy one and can be dequeued past
+		x := next.value
+		if q.head.CompareAndSwap(head, next) {
+			q.length.Add(-1)
+			return x, true
+		}
+	}
+}
+
+// Len returns the number of elements in the queue.
+// Under concurrent use the result is only approximate: operations that are in progress
+// may or may not be counted, and the value may be stale by the time it is used.
+// The time complexity of this operation is O(1).
+func (q *LockFreeQueue[T]) Len() int {
+	// a TryDequeue may be counted before the Enqueue of the same element
+	return max(int(q.length.Load()), 0)
+}
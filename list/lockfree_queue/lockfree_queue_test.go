@@ -0,0 +1,201 @@
+package lockfreequeue
+
+import (
+	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+
+	singlylinkedlist "github.com/GrzegorzMika/data-structures/list/singly_linked_list"
+)
+
+func TestNewLockFreeQueue(t *testing.T) {
+	q := NewLockFreeQueue[int]()
+	if q.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", q.Len())
+	}
+	x, ok := q.TryDequeue()
+	if ok || x != 0 {
+		t.Errorf("TryDequeue() = (%v, %t), want (0, false)", x, ok)
+	}
+}
+
+func TestFIFO(t *testing.T) {
+	q := NewLockFreeQueue[int]()
+	for i := range 10 {
+		q.Enqueue(i)
+	}
+	if q.Len() != 10 {
+		t.Errorf("Len() = %d, want 10", q.Len())
+	}
+	for i := range 10 {
+		x, ok := q.TryDequeue()
+		if !ok || x != i {
+			t.Errorf("TryDequeue() = (%v, %t), want (%d, true)", x, ok, i)
+		}
+	}
+	x, ok := q.TryDequeue()
+	if ok || x != 0 {
+		t.Errorf("TryDequeue() = (%v, %t), want (0, false)", x, ok)
+	}
+
+	// the queue keeps working after it was drained
+	q.Enqueue(10)
+	x, ok = q.TryDequeue()
+	if !ok || x != 10 {
+		t.Errorf("TryDequeue() = (%v, %t), want (10, true)", x, ok)
+	}
+	if q.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", q.Len())
+	}
+}
+
+// TestConcurrent checks that under concurrent use every element is dequeued exactly once
+// and that the elements of every producer are dequeued in the order they were enqueued.
+// Run it with the race detector to check the memory accesses as well.
+func TestConcurrent(t *testing.T) {
+	const (
+		producers = 8
+		consumers = 8
+		perWorker = 5000
+	)
+	q := NewLockFreeQueue[[2]int]()
+
+	var wg sync.WaitGroup
+	for p := range producers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWorker {
+				q.Enqueue([2]int{p, i})
+			}
+		}()
+	}
+
+	results := make(chan [][2]int, consumers)
+	remaining := make(chan struct{}, producers*perWorker)
+	for range producers * perWorker {
+		remaining <- struct{}{}
+	}
+	close(remaining)
+	for range consumers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var got [][2]int
+			for range remaining {
+				for {
+					if x, ok := q.TryDequeue(); ok {
+						got = append(got, x)
+						break
+					}
+					runtime.Gosched()
+				}
+			}
+			results <- got
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	seen := make([][]bool, producers)
+	for p := range seen {
+		seen[p] = make([]bool, perWorker)
+	}
+	for got := range results {
+		last := slices.Repeat([]int{-1}, producers)
+		for _, x := range got {
+			p, i := x[0], x[1]
+			if seen[p][i] {
+				t.Fatalf("element %v dequeued twice", x)
+			}
+			seen[p][i] = true
+			if i <= last[p] {
+				t.Fatalf("element %v of producer %d dequeued after element %d", x, p, last[p])
+			}
+			last[p] = i
+		}
+	}
+	for p := range seen {
+		if i := slices.Index(seen[p], false); i >= 0 {
+			t.Fatalf("element [%d %d] was never dequeued", p, i)
+		}
+	}
+	if q.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", q.Len())
+	}
+}
+
+type lockedList struct {
+	mu   sync.Mutex
+	list *singlylinkedlist.SinglyLinkedList[int]
+}
+
+func (l *lockedList) Enqueue(x int) {
+	l.mu.Lock()
+	l.list.PushBack(x)
+	l.mu.Unlock()
+}
+
+func (l *lockedList) TryDequeue() (int, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.list.PopFront()
+}
+
+type channelQueue chan int
+
+func (c channelQueue) Enqueue(x int) {
+	c <- x
+}
+
+func (c channelQueue) TryDequeue() (int, bool) {
+	select {
+	case x := <-c:
+		return x, true
+	default:
+		return 0, false
+	}
+}
+
+type queue interface {
+	Enqueue(int)
+	TryDequeue() (int, bool)
+}
+
+func benchmarkQueue(b *testing.B, newQueue func() queue) {
+	for _, parallelism := range []int{1, 4, 32} {
+		b.Run(fmt.Sprintf("goroutines=%d", parallelism*runtime.GOMAXPROCS(0)), func(b *testing.B) {
+			q := newQueue()
+			for i := range 1024 {
+				q.Enqueue(i)
+			}
+			b.SetParallelism(parallelism)
+			b.RunParallel(func(pb *testing.PB) {
+				i := 0
+				for pb.Next() {
+					q.Enqueue(i)
+					q.TryDequeue()
+					i++
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkLockFreeQueue(b *testing.B) {
+	benchmarkQueue(b, func() queue { return NewLockFreeQueue[int]() })
+}
+
+func BenchmarkMutexSinglyLinkedList(b *testing.B) {
+	benchmarkQueue(b, func() queue {
+		return &lockedList{list: singlylinkedlist.NewSinglyLinkedList[int]()}
+	})
+}
+
+// BenchmarkChannel uses a buffered channel; every goroutine dequeues right after it enqueues,
+// so the buffer never holds more than 1024 plus the number of goroutines elements.
+func BenchmarkChannel(b *testing.B) {
+	benchmarkQueue(b, func() queue { return make(channelQueue, 1<<16) })
+}
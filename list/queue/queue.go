@@ -0,0 +1,58 @@
+// Package queue implements a generic FIFO queue backed by a singly linked list.
+package queue
+
+import singlylinkedlist "github.com/GrzegorzMika/data-structures/list/singly_linked_list"
+
+// Queue is a first-in-first-out collection. Elements are enqueued at the back of the underlying list,
+// which keeps a tail pointer, and dequeued from its front, so all operations are O(1).
+// The zero value is an empty queue ready to use.
+type Queue[T any] struct {
+	list singlylinkedlist.SinglyLinkedList[T]
+}
+
+// NewQueue creates and returns a new instance of an empty queue.
+//
+// Example usage:
+//
+//	q := queue.NewQueue[int]()
+//	q.Enqueue(1)
+//	x, ok := q.Dequeue()
+func NewQueue[T any]() *Queue[T] {
+	return &Queue[T]{}
+}
+
+// Enqueue adds x to the back of the queue.
+// The time complexity of this operation is O(1).
+func (q *Queue[T]) Enqueue(x T) {
+	q.list.PushBack(x)
+}
+
+// Dequeue removes and returns the element at the front of the queue and true.
+// If the queue is empty, it returns the zero value of type T and false.
+// The time complexity of this operation is O(1).
+func (q *Queue[T]) Dequeue() (T, bool) {
+	return q.list.PopFront()
+}
+
+// Peek returns the element at the front of the queue and true without removing it.
+// If the queue is empty, it returns the zero value of type T and false.
+// The time complexity of this operation is O(1).
+func (q *Queue[T]) Peek() (T, bool) {
+	n, ok := q.list.Front()
+	if !ok {
+		return *new(T), false
+	}
+	return n.Value(), true
+}
+
+// Len returns the number of elements in the queue.
+// The time complexity of this operation is O(1).
+func (q *Queue[T]) Len() int {
+	return q.list.Len()
+}
+
+// IsEmpty reports whether the queue is empty.
+// The time complexity of this operation is O(1).
+func (q *Queue[T]) IsEmpty() bool {
+	return q.list.Len() == 0
+}
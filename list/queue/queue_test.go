@@ -0,0 +1,62 @@
+package queue
+
+import "testing"
+
+func TestNewQueue(t *testing.T) {
+	q := NewQueue[int]()
+	if !q.IsEmpty() || q.Len() != 0 {
+		t.Errorf("IsEmpty() = %t and Len() = %d, want true and 0", q.IsEmpty(), q.Len())
+	}
+	x, ok := q.Dequeue()
+	if ok || x != 0 {
+		t.Errorf("Dequeue() = (%v, %t), want (0, false)", x, ok)
+	}
+	x, ok = q.Peek()
+	if ok || x != 0 {
+		t.Errorf("Peek() = (%v, %t), want (0, false)", x, ok)
+	}
+}
+
+func TestFIFO(t *testing.T) {
+	q := NewQueue[int]()
+	for i := range 5 {
+		q.Enqueue(i)
+	}
+	if q.Len() != 5 {
+		t.Errorf("Len() = %d, want 5", q.Len())
+	}
+	for i := range 5 {
+		x, ok := q.Peek()
+		if !ok || x != i {
+			t.Errorf("Peek() = (%v, %t), want (%d, true)", x, ok, i)
+		}
+		x, ok = q.Dequeue()
+		if !ok || x != i {
+			t.Errorf("Dequeue() = (%v, %t), want (%d, true)", x, ok, i)
+		}
+	}
+	if !q.IsEmpty() {
+		t.Errorf("IsEmpty() = false, want true")
+	}
+
+	// the queue keeps working after it was drained
+	q.Enqueue(5)
+	if x, ok := q.Dequeue(); !ok || x != 5 {
+		t.Errorf("Dequeue() = (%v, %t), want (5, true)", x, ok)
+	}
+}
+
+func TestZeroValueQueue(t *testing.T) {
+	var q Queue[int]
+	if x, ok := q.Peek(); ok || x != 0 {
+		t.Errorf("Peek() = (%v, %t), want (0, false)", x, ok)
+	}
+	q.Enqueue(1)
+	q.Enqueue(2)
+	if x, ok := q.Dequeue(); !ok || x != 1 {
+		t.Errorf("Dequeue() = (%v, %t), want (1, true)", x, ok)
+	}
+	if q.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", q.Len())
+	}
+}
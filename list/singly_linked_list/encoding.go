@@ -0,0 +1,79 @@
+package singlylinkedlist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// MarshalJSON implements json.Marshaler.
+// Like the other encoding methods, it has a value receiver, so that lists held by value,
+// for example as struct fields, are encoded as well.
+// The list is encoded as a JSON array of its elements, from front to back; an empty list is encoded as [].
+func (l SinglyLinkedList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.slice())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It replaces the contents of the list with the elements of the JSON array, from front to back.
+// A JSON null leaves the list unchanged, as does an error.
+func (l *SinglyLinkedList[T]) UnmarshalJSON(data []byte) error {
+	var xs []T
+	if err := json.Unmarshal(data, &xs); err != nil {
+		return err
+	}
+	if xs == nil && bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	l.replace(xs)
+	return nil
+}
+
+// GobEncode implements gob.GobEncoder.
+// The list is encoded as a gob-encoded slice of its elements, from front to back.
+func (l SinglyLinkedList[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(l.slice()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements gob.GobDecoder.
+// It replaces the contents of the list with the decoded elements. If an error occurs, the list is left unchanged.
+func (l *SinglyLinkedList[T]) GobDecode(data []byte) error {
+	var xs []T
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&xs); err != nil {
+		return err
+	}
+	l.replace(xs)
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler using the gob encoding of the list.
+func (l SinglyLinkedList[T]) MarshalBinary() ([]byte, error) {
+	return l.GobEncode()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler using the gob encoding of the list.
+// It replaces the contents of the list with the decoded elements. If an error occurs, the list is left unchanged.
+func (l *SinglyLinkedList[T]) UnmarshalBinary(data []byte) error {
+	return l.GobDecode(data)
+}
+
+// slice returns the elements of the list, from front to back, as a non-nil slice.
+func (l *SinglyLinkedList[T]) slice() []T {
+	xs := make([]T, 0, l.length)
+	for n := l.head; n != nil; n = n.next {
+		xs = append(xs, n.value)
+	}
+	return xs
+}
+
+// replace replaces the contents of the list with xs.
+func (l *SinglyLinkedList[T]) replace(xs []T) {
+	l.Clear()
+	for _, x := range xs {
+		l.PushBack(x)
+	}
+}
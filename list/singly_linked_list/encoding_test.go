@@ -0,0 +1,201 @@
+package singlylinkedlist
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+var (
+	_ json.Marshaler             = SinglyLinkedList[int]{}
+	_ json.Unmarshaler           = (*SinglyLinkedList[int])(nil)
+	_ gob.GobEncoder             = SinglyLinkedList[int]{}
+	_ gob.GobDecoder             = (*SinglyLinkedList[int])(nil)
+	_ encoding.BinaryMarshaler   = SinglyLinkedList[int]{}
+	_ encoding.BinaryUnmarshaler = (*SinglyLinkedList[int])(nil)
+)
+
+type payload struct {
+	Name  string
+	Items *SinglyLinkedList[string]
+}
+
+// valuePayload holds the list by value, which needs the encoding methods to have value receivers.
+type valuePayload struct {
+	Name  string
+	Items SinglyLinkedList[string]
+}
+
+func newValuePayload() valuePayload {
+	p := valuePayload{Name: "p"}
+	p.Items.PushBack("a")
+	p.Items.PushBack("b")
+	return p
+}
+
+func TestEncodeValueField(t *testing.T) {
+	check := func(t *testing.T, got valuePayload) {
+		t.Helper()
+		assertInvariants(t, &got.Items)
+		if got.Name != "p" {
+			t.Errorf("Name = %q, want %q", got.Name, "p")
+		}
+		if values := slices.Collect(got.Items.Values()); !slices.Equal(values, []string{"a", "b"}) {
+			t.Errorf("values = %v, want [a b]", values)
+		}
+	}
+
+	t.Run("json", func(t *testing.T) {
+		data, err := json.Marshal(newValuePayload())
+		if want := `{"Name":"p","Items":["a","b"]}`; err != nil || string(data) != want {
+			t.Fatalf("json.Marshal() = (%s, %v), want (%s, nil)", data, err, want)
+		}
+		var got valuePayload
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("json.Unmarshal() = %v, want nil", err)
+		}
+		check(t, got)
+	})
+
+	t.Run("gob", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(newValuePayload()); err != nil {
+			t.Fatalf("Encode() = %v, want nil", err)
+		}
+		var got valuePayload
+		if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+			t.Fatalf("Decode() = %v, want nil", err)
+		}
+		check(t, got)
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		p := newValuePayload()
+		var m encoding.BinaryMarshaler = p.Items
+		data, err := m.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary() = %v, want nil", err)
+		}
+		got := valuePayload{Name: "p"}
+		if err := got.Items.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary() = %v, want nil", err)
+		}
+		check(t, got)
+	})
+
+	t.Run("plain value", func(t *testing.T) {
+		data, err := json.Marshal(*newList(1, 2))
+		if err != nil || string(data) != "[1,2]" {
+			t.Errorf("json.Marshal() = (%s, %v), want ([1,2], nil)", data, err)
+		}
+	})
+}
+
+func TestJSON(t *testing.T) {
+	type testCase struct {
+		name string
+		list []int
+		want string
+	}
+	for _, tc := range []testCase{
+		{name: "empty", list: nil, want: "[]"},
+		{name: "non-empty", list: []int{1, 2, 3}, want: "[1,2,3]"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(newList(tc.list...))
+			if err != nil || string(data) != tc.want {
+				t.Fatalf("json.Marshal() = (%s, %v), want (%s, nil)", data, err, tc.want)
+			}
+			l := newList(9)
+			if err := json.Unmarshal(data, l); err != nil {
+				t.Fatalf("json.Unmarshal() = %v, want nil", err)
+			}
+			assertInvariants(t, l)
+			if got := slices.Collect(l.Values()); !slices.Equal(got, tc.list) {
+				t.Errorf("values = %v, want %v", got, tc.list)
+			}
+		})
+	}
+}
+
+func TestJSONEmbedded(t *testing.T) {
+	items := NewSinglyLinkedList[string]()
+	items.PushBack("a")
+	items.PushBack("b")
+	data, err := json.Marshal(payload{Name: "p", Items: items})
+	if want := `{"Name":"p","Items":["a","b"]}`; err != nil || string(data) != want {
+		t.Fatalf("json.Marshal() = (%s, %v), want (%s, nil)", data, err, want)
+	}
+
+	var got payload
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("json.Unmarshal() = %v, want nil", err)
+	}
+	assertInvariants(t, got.Items)
+	if values := slices.Collect(got.Items.Values()); !slices.Equal(values, []string{"a", "b"}) {
+		t.Errorf("values = %v, want [a b]", values)
+	}
+}
+
+func TestUnmarshalJSONKeepsListOnError(t *testing.T) {
+	for _, data := range []string{`["a"]`, `{}`, `null`} {
+		l := newList(1, 2)
+		err := json.Unmarshal([]byte(data), l)
+		if (data == "null") != (err == nil) {
+			t.Errorf("json.Unmarshal(%s) = %v", data, err)
+		}
+		assertInvariants(t, l)
+		if got := slices.Collect(l.Values()); !slices.Equal(got, []int{1, 2}) {
+			t.Errorf("values after json.Unmarshal(%s) = %v, want [1 2]", data, got)
+		}
+	}
+}
+
+func TestGob(t *testing.T) {
+	items := NewSinglyLinkedList[string]()
+	items.PushBack("a")
+	items.PushBack("b")
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(payload{Name: "p", Items: items}); err != nil {
+		t.Fatalf("Encode() = %v, want nil", err)
+	}
+	var got payload
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("Decode() = %v, want nil", err)
+	}
+	assertInvariants(t, got.Items)
+	if got.Name != "p" {
+		t.Errorf("Name = %q, want %q", got.Name, "p")
+	}
+	if values := slices.Collect(got.Items.Values()); !slices.Equal(values, []string{"a", "b"}) {
+		t.Errorf("values = %v, want [a b]", values)
+	}
+}
+
+func TestBinary(t *testing.T) {
+	for _, xs := range [][]int{nil, {1, 2, 3}} {
+		data, err := newList(xs...).MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary() = %v, want nil", err)
+		}
+		l := newList(9)
+		if err := l.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary() = %v, want nil", err)
+		}
+		assertInvariants(t, l)
+		if got := slices.Collect(l.Values()); !slices.Equal(got, xs) {
+			t.Errorf("values = %v, want %v", got, xs)
+		}
+	}
+
+	l := newList(1, 2)
+	if err := l.UnmarshalBinary([]byte("garbage")); err == nil {
+		t.Errorf("UnmarshalBinary() of garbage = nil, want an error")
+	}
+	if got := slices.Collect(l.Values()); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("values = %v, want [1 2]", got)
+	}
+}
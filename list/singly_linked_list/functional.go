@@ -0,0 +1,139 @@
+package singlylinkedlist
+
+// Map returns a new list with the results of applying f to every element of the list, in order.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func Map[T, U any](l *SinglyLinkedList[T], f func(T) U) *SinglyLinkedList[U] {
+	out := NewSinglyLinkedList[U]()
+	for n := l.head; n != nil; n = n.next {
+		out.PushBack(f(n.value))
+	}
+	return out
+}
+
+// FlatMap returns a new list with the elements of the lists returned by f for every element of the list, in order.
+// The elements are copied, so the lists returned by f can be reused afterwards.
+// The time complexity of this operation is O(n+m), where n is the length of the list
+// and m is the total length of the lists returned by f.
+func FlatMap[T, U any](l *SinglyLinkedList[T], f func(T) *SinglyLinkedList[U]) *SinglyLinkedList[U] {
+	out := NewSinglyLinkedList[U]()
+	for n := l.head; n != nil; n = n.next {
+		for m := f(n.value).head; m != nil; m = m.next {
+			out.PushBack(m.value)
+		}
+	}
+	return out
+}
+
+// Filter returns a new list with the elements of the list satisfying pred, in order.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func Filter[T any](l *SinglyLinkedList[T], pred func(T) bool) *SinglyLinkedList[T] {
+	out := NewSinglyLinkedList[T]()
+	for n := l.head; n != nil; n = n.next {
+		if pred(n.value) {
+			out.PushBack(n.value)
+		}
+	}
+	return out
+}
+
+// Partition returns two new lists: the first one with the elements of the list satisfying pred
+// and the second one with the remaining elements. Both lists keep the order of the original list.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func Partition[T any](l *SinglyLinkedList[T], pred func(T) bool) (*SinglyLinkedList[T], *SinglyLinkedList[T]) {
+	matching, rest := NewSinglyLinkedList[T](), NewSinglyLinkedList[T]()
+	for n := l.head; n != nil; n = n.next {
+		if pred(n.value) {
+			matching.PushBack(n.value)
+		} else {
+			rest.PushBack(n.value)
+		}
+	}
+	return matching, rest
+}
+
+// Fold combines the elements of the list from front to back with f, starting from init,
+// and returns the result. For an empty list it returns init.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func Fold[T, U any](l *SinglyLinkedList[T], init U, f func(U, T) U) U {
+	acc := init
+	for n := l.head; n != nil; n = n.next {
+		acc = f(acc, n.value)
+	}
+	return acc
+}
+
+// Reduce combines the elements of the list from front to back with f, using the first element
+// as the initial value, and returns the result and true.
+// If the list is empty, it returns the zero value of type T and false.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func Reduce[T any](l *SinglyLinkedList[T], f func(T, T) T) (T, bool) {
+	if l.length == 0 {
+		return *new(T), false
+	}
+	acc := l.head.value
+	for n := l.head.next; n != nil; n = n.next {
+		acc = f(acc, n.value)
+	}
+	return acc, true
+}
+
+// Find returns the first element of the list satisfying pred and true.
+// If there is no such element, it returns the zero value of type T and false.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func Find[T any](l *SinglyLinkedList[T], pred func(T) bool) (T, bool) {
+	for n := l.head; n != nil; n = n.next {
+		if pred(n.value) {
+			return n.value, true
+		}
+	}
+	return *new(T), false
+}
+
+// Any reports whether at least one element of the list satisfies pred.
+// It returns false for an empty list.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func Any[T any](l *SinglyLinkedList[T], pred func(T) bool) bool {
+	_, ok := Find(l, pred)
+	return ok
+}
+
+// All reports whether every element of the list satisfies pred.
+// It returns true for an empty list.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func All[T any](l *SinglyLinkedList[T], pred func(T) bool) bool {
+	return !Any(l, func(x T) bool { return !pred(x) })
+}
+
+// RemoveIf removes all elements satisfying pred from the list and returns the number of removed elements.
+// The order of the remaining elements is preserved.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func (l *SinglyLinkedList[T]) RemoveIf(pred func(T) bool) int {
+	removed := 0
+	// previous is the last kept node, or nil if no node was kept yet
+	var previous *Node[T]
+	for n := l.head; n != nil; {
+		next := n.next
+		if pred(n.value) {
+			if previous == nil {
+				l.head = next
+			} else {
+				previous.next = next
+			}
+			detach(n)
+			removed++
+		} else {
+			previous = n
+		}
+		n = next
+	}
+	l.tail = previous
+	l.length -= removed
+	return removed
+}
+
+// RetainIf keeps only the elements satisfying pred in the list and returns the number of removed elements.
+// The order of the remaining elements is preserved.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func (l *SinglyLinkedList[T]) RetainIf(pred func(T) bool) int {
+	return l.RemoveIf(func(x T) bool { return !pred(x) })
+}
@@ -0,0 +1,155 @@
+package singlylinkedlist
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func isEven(x int) bool { return x%2 == 0 }
+
+func TestMap(t *testing.T) {
+	l := newList(1, 2, 3)
+	got := Map(l, strconv.Itoa)
+	assertInvariants(t, got)
+	if values := slices.Collect(got.Values()); !slices.Equal(values, []string{"1", "2", "3"}) {
+		t.Errorf("Map() = %v, want [1 2 3]", values)
+	}
+	if empty := Map(NewSinglyLinkedList[int](), strconv.Itoa); empty.Len() != 0 {
+		t.Errorf("Map() of an empty list has length %d, want 0", empty.Len())
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	l := newList(1, 2, 3)
+	repeat := func(x int) *SinglyLinkedList[int] {
+		out := NewSinglyLinkedList[int]()
+		for range x {
+			out.PushBack(x)
+		}
+		return out
+	}
+	got := FlatMap(l, repeat)
+	assertInvariants(t, got)
+	if values := slices.Collect(got.Values()); !slices.Equal(values, []int{1, 2, 2, 3, 3, 3}) {
+		t.Errorf("FlatMap() = %v, want [1 2 2 3 3 3]", values)
+	}
+}
+
+func TestFilterPartition(t *testing.T) {
+	l := newList(1, 2, 3, 4, 5)
+	even := Filter(l, isEven)
+	assertInvariants(t, even)
+	if values := slices.Collect(even.Values()); !slices.Equal(values, []int{2, 4}) {
+		t.Errorf("Filter() = %v, want [2 4]", values)
+	}
+
+	matching, rest := Partition(l, isEven)
+	assertInvariants(t, matching)
+	assertInvariants(t, rest)
+	if values := slices.Collect(matching.Values()); !slices.Equal(values, []int{2, 4}) {
+		t.Errorf("Partition() matching = %v, want [2 4]", values)
+	}
+	if values := slices.Collect(rest.Values()); !slices.Equal(values, []int{1, 3, 5}) {
+		t.Errorf("Partition() rest = %v, want [1 3 5]", values)
+	}
+	// the source list is left unchanged
+	if values := slices.Collect(l.Values()); !slices.Equal(values, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("values = %v, want [1 2 3 4 5]", values)
+	}
+}
+
+func TestFoldReduce(t *testing.T) {
+	l := newList(1, 2, 3, 4)
+	if got := Fold(l, "", func(acc string, x int) string { return acc + strconv.Itoa(x) }); got != "1234" {
+		t.Errorf("Fold() = %q, want %q", got, "1234")
+	}
+	if got := Fold(NewSinglyLinkedList[int](), 7, func(acc, x int) int { return acc + x }); got != 7 {
+		t.Errorf("Fold() of an empty list = %d, want 7", got)
+	}
+
+	x, ok := Reduce(l, func(a, b int) int { return a - b })
+	if !ok || x != -8 {
+		t.Errorf("Reduce() = (%v, %t), want (-8, true)", x, ok)
+	}
+	x, ok = Reduce(NewSinglyLinkedList[int](), func(a, b int) int { return a + b })
+	if ok || x != 0 {
+		t.Errorf("Reduce() = (%v, %t), want (0, false)", x, ok)
+	}
+}
+
+func TestFindAnyAll(t *testing.T) {
+	l := newList(1, 3, 4, 6)
+	x, ok := Find(l, isEven)
+	if !ok || x != 4 {
+		t.Errorf("Find() = (%v, %t), want (4, true)", x, ok)
+	}
+	x, ok = Find(l, func(x int) bool { return x > 10 })
+	if ok || x != 0 {
+		t.Errorf("Find() = (%v, %t), want (0, false)", x, ok)
+	}
+
+	empty := NewSinglyLinkedList[int]()
+	type testCase struct {
+		name string
+		list *SinglyLinkedList[int]
+		any  bool
+		all  bool
+	}
+	for _, tc := range []testCase{
+		{name: "mixed", list: l, any: true, all: false},
+		{name: "all even", list: newList(2, 4), any: true, all: true},
+		{name: "none even", list: newList(1, 3), any: false, all: false},
+		{name: "empty", list: empty, any: false, all: true},
+	} {
+		if got := Any(tc.list, isEven); got != tc.any {
+			t.Errorf("%s: Any() = %t, want %t", tc.name, got, tc.any)
+		}
+		if got := All(tc.list, isEven); got != tc.all {
+			t.Errorf("%s: All() = %t, want %t", tc.name, got, tc.all)
+		}
+	}
+}
+
+func TestRemoveIf(t *testing.T) {
+	type testCase struct {
+		name    string
+		list    []int
+		removed int
+		want    []int
+	}
+	testCases := []testCase{
+		{name: "empty", list: nil, removed: 0, want: nil},
+		{name: "none", list: []int{1, 3}, removed: 0, want: []int{1, 3}},
+		{name: "all", list: []int{2, 4}, removed: 2, want: nil},
+		{name: "head", list: []int{2, 4, 5, 7}, removed: 2, want: []int{5, 7}},
+		{name: "tail", list: []int{1, 3, 4, 6}, removed: 2, want: []int{1, 3}},
+		{name: "interleaved", list: []int{1, 2, 3, 4, 5}, removed: 2, want: []int{1, 3, 5}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := newList(tc.list...)
+			if removed := l.RemoveIf(isEven); removed != tc.removed {
+				t.Errorf("RemoveIf() = %d, want %d", removed, tc.removed)
+			}
+			assertInvariants(t, l)
+			if got := slices.Collect(l.Values()); !slices.Equal(got, tc.want) {
+				t.Errorf("values = %v, want %v", got, tc.want)
+			}
+			// the tail stays usable
+			l.PushBack(9)
+			assertInvariants(t, l)
+		})
+	}
+}
+
+func TestRetainIf(t *testing.T) {
+	l := newList(1, 2, 3, 4, 5)
+	if removed := l.RetainIf(isEven); removed != 3 {
+		t.Errorf("RetainIf() = %d, want 3", removed)
+	}
+	assertInvariants(t, l)
+	if got := slices.Collect(l.Values()); !slices.Equal(got, []int{2, 4}) {
+		t.Errorf("values = %v, want [2 4]", got)
+	}
+}
@@ -1,16 +1,63 @@
 // Package singlylinkedlist implements the generic singly linked list.
 package singlylinkedlist
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// ErrIndexOutOfRange is returned by the indexed methods when the index is outside of the list.
+// The returned errors wrap it together with the index and the length of the list.
+var ErrIndexOutOfRange = errors.New("singlylinkedlist: index out of range")
+
+// ErrForeignNode is returned by the node-level methods when the node does not belong to the list.
+var ErrForeignNode = errors.New("singlylinkedlist: node does not belong to the list")
 
 type Node[T any] struct {
-	Data *T
-	next *Node[T]
+	value T
+	next  *Node[T]
+	// owner identifies the list the node belongs to; it is nil if the node was removed
+	owner *owner
+}
+
+// Value returns the element stored in the node.
+// The time complexity of this operation is O(1).
+func (n *Node[T]) Value() T {
+	return n.value
+}
+
+// Set replaces the element stored in the node with v.
+// The time complexity of this operation is O(1).
+func (n *Node[T]) Set(v T) {
+	n.value = v
+}
+
+// Data returns a pointer to the element stored in the node.
+// It eases the migration from the former exported Data field: an expression like *node.Data
+// becomes *node.Data().
+//
+// Deprecated: Use Value and Set instead.
+func (n *Node[T]) Data() *T {
+	return &n.value
+}
+
+// Next returns the next node of the list or nil if the node is the last one
+// or does not belong to a list anymore.
+// The time complexity of this operation is O(1).
+func (n *Node[T]) Next() *Node[T] {
+	if n.owner == nil {
+		return nil
+	}
+	return n.next
 }
 
 type SinglyLinkedList[T any] struct {
 	head   *Node[T]
+	tail   *Node[T]
 	length int
+	// owner is shared by the nodes of the list; it is created lazily
+	owner *owner
 }
 
 // NewSinglyLinkedList creates and returns a new instance of a singly linked list.
@@ -24,24 +71,30 @@ func NewSinglyLinkedList[T any]() *SinglyLinkedList[T] {
 	return &SinglyLinkedList[T]{}
 }
 
-// Append appends all elements from the other list to the end of the current list.
+// Append moves all elements from the other list to the end of the current list and leaves the other list empty.
+// The nodes of the other list are linked to the tail of the current list rather than copied,
+// so nodes obtained from the other list now belong to the current list.
+// Use Splice to insert the other list at a different position.
 // If the other list is empty, this function does nothing.
-// Time complexity: O(n), where n is the length of the other list.
+//
+// It panics if other is the list itself.
+// Time complexity: O(1), as it only requires linking the tail of the current list to the head of the other list.
 func (l *SinglyLinkedList[T]) Append(other *SinglyLinkedList[T]) {
-	if other.Len() == 0 {
+	if l == other {
+		panic("singlylinkedlist: cannot append a list to itself")
+	}
+	if other.length == 0 {
 		return
 	}
+	l.handOver(other)
 	if l.length == 0 {
 		l.head = other.head
-		l.length = other.length
-		return
-	}
-	node := l.head
-	for node.next != nil {
-		node = node.next
+	} else {
+		l.tail.next = other.head
 	}
-	node.next = other.head
+	l.tail = other.tail
 	l.length += other.length
+	other.head, other.tail, other.length = nil, nil, 0
 }
 
 // Len returns the number of elements in the list.
@@ -53,17 +106,20 @@ func (l *SinglyLinkedList[T]) Len() int {
 // Clear removes all elements from the list.
 // It iterates through the list, setting the next pointer of each node to nil,
 // allowing the garbage collector to reclaim the memory occupied by the nodes.
-// After clearing the list, it sets the head and length to nil and 0 respectively.
+// After clearing the list, it sets the head and tail to nil and the length to 0.
 // The time complexity of this operation is O(n), where n is the length of the list.
 func (l *SinglyLinkedList[T]) Clear() {
 	// dereference object to allow GC to clean up
 	for n := l.head; n != nil; {
 		tmp := n.next
 		n.next = nil
+		n.owner = nil
 		n = tmp
 	}
 	l.length = 0
 	l.head = nil
+	l.tail = nil
+	l.owner = nil
 }
 
 // Contains checks if the list contains a specific element based on the provided equality function.
@@ -72,13 +128,91 @@ func (l *SinglyLinkedList[T]) Clear() {
 // If no such element is found, it returns false.
 func (l *SinglyLinkedList[T]) Contains(x T, eq func(T, T) bool) bool {
 	for n := l.head; n != nil; n = n.next {
-		if eq(*n.Data, x) {
+		if eq(n.value, x) {
 			return true
 		}
 	}
 	return false
 }
 
+// Get returns the element at the specified index.
+// If the index is out of range, it returns the zero value of type T and an error wrapping ErrIndexOutOfRange.
+// The time complexity of this operation is O(i), where i is the index.
+func (l *SinglyLinkedList[T]) Get(i int) (T, error) {
+	if err := l.checkIndex(i, l.length); err != nil {
+		return *new(T), err
+	}
+	return l.node(i).value, nil
+}
+
+// Set replaces the element at the specified index with x.
+// If the index is out of range, the list is left unchanged and an error wrapping ErrIndexOutOfRange is returned.
+// The time complexity of this operation is O(i), where i is the index.
+func (l *SinglyLinkedList[T]) Set(i int, x T) error {
+	if err := l.checkIndex(i, l.length); err != nil {
+		return err
+	}
+	l.node(i).value = x
+	return nil
+}
+
+// Insert inserts x at the specified index, shifting the element at that index and all following ones
+// back by one position. An index equal to Len() appends x to the end of the list.
+// If the index is out of range, the list is left unchanged and an error wrapping ErrIndexOutOfRange is returned.
+// The time complexity of this operation is O(i), where i is the index; inserting at either end is O(1).
+func (l *SinglyLinkedList[T]) Insert(i int, x T) error {
+	if err := l.checkIndex(i, l.length+1); err != nil {
+		return err
+	}
+	switch i {
+	case 0:
+		l.PushFront(x)
+	case l.length:
+		l.PushBack(x)
+	default:
+		previous := l.node(i - 1)
+		previous.next = &Node[T]{value: x, next: previous.next, owner: l.token()}
+		l.length++
+	}
+	return nil
+}
+
+// IndexOf returns the index of the first element equal to x according to the provided equality function,
+// or -1 if there is no such element.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func (l *SinglyLinkedList[T]) IndexOf(x T, eq func(T, T) bool) int {
+	return l.IndexFunc(func(y T) bool { return eq(y, x) })
+}
+
+// IndexFunc returns the index of the first element satisfying pred, or -1 if there is no such element.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func (l *SinglyLinkedList[T]) IndexFunc(pred func(T) bool) int {
+	i := 0
+	for n := l.head; n != nil; n = n.next {
+		if pred(n.value) {
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// LastIndexOf returns the index of the last element equal to x according to the provided equality function,
+// or -1 if there is no such element.
+// As the list can only be traversed forwards, it always visits all elements.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func (l *SinglyLinkedList[T]) LastIndexOf(x T, eq func(T, T) bool) int {
+	last := -1
+	i := 0
+	for n := l.head; n != nil; n = n.next {
+		if eq(n.value, x) {
+			last = i
+		}
+		i++
+	}
+	return last
+}
+
 // Front returns the first node of the list and a boolean indicating if the list is not empty.
 // If the list is empty, it returns nil and false.
 //
@@ -93,50 +227,103 @@ func (l *SinglyLinkedList[T]) Front() (*Node[T], bool) {
 	return l.head, true
 }
 
+// All returns an iterator over the index-value pairs of the list, from front to back.
+// It is meant to be used with a for-range loop:
+//
+//	for i, x := range list.All() {
+//		fmt.Println(i, x)
+//	}
+//
+// It is safe to remove the current element from the list during iteration, for example with Remove(i);
+// the iteration continues with the element that followed it, which then gets the same index.
+func (l *SinglyLinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		// previous is the last yielded node that is still in the list
+		var previous *Node[T]
+		for n := l.head; n != nil; {
+			next := n.next
+			if !yield(i, n.value) {
+				return
+			}
+			// advance the index only if the current node was not removed by the caller
+			if (previous == nil && l.head == n) || (previous != nil && previous.next == n) {
+				previous = n
+				i++
+			}
+			n = next
+		}
+	}
+}
+
+// Values returns an iterator over the values of the list, from front to back.
+// It is safe to remove the current element from the list during iteration.
+func (l *SinglyLinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := range l.Nodes() {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Nodes returns an iterator over the nodes of the list, from front to back.
+// It is safe to remove the current node from the list during iteration,
+// as the next node is looked up before the current one is yielded.
+func (l *SinglyLinkedList[T]) Nodes() iter.Seq[*Node[T]] {
+	return func(yield func(*Node[T]) bool) {
+		for n := l.head; n != nil; {
+			next := n.next
+			if !yield(n) {
+				return
+			}
+			n = next
+		}
+	}
+}
+
 // Back returns the last node of the list and a boolean indicating if the list is not empty.
 // If the list is empty, it returns nil and false.
 //
-// The function iterates through the list until it finds the last node (i.e., a node where next is nil).
-// It then returns a pointer to the last node and true.
-// If the list is empty (i.e., head is nil), it returns nil and false.
-//
-// The time complexity of this operation is O(n), where n is the length of the list.
+// The time complexity of this operation is O(1), as it only requires accessing the tail pointer.
 func (l *SinglyLinkedList[T]) Back() (*Node[T], bool) {
-	for n := l.head; n != nil; n = n.next {
-		if n.next == nil {
-			return n, true
-		}
+	if l.length == 0 {
+		return nil, false
 	}
-	return nil, false
+	return l.tail, true
 }
 
 // Remove removes and returns the element at the specified index from the list.
-// If the index is out of range, it panics with an error message.
+// If the index is out of range, it panics with an error wrapping ErrIndexOutOfRange;
+// use RemoveAt to handle invalid indices without panicking.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func (l *SinglyLinkedList[T]) Remove(at int) T {
+	x, err := l.RemoveAt(at)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// RemoveAt removes and returns the element at the specified index from the list.
+// If the index is out of range, the list is left unchanged and
+// the zero value of type T and an error wrapping ErrIndexOutOfRange are returned.
 // If the index is 0, it updates the head of the list to the next node.
 // Otherwise, it traverses the list to find the node at the specified index,
 // updates the previous node's next pointer to skip the current node,
 // and decrements the list's length.
 // The time complexity of this operation is O(n), where n is the length of the list.
-func (l *SinglyLinkedList[T]) Remove(at int) T {
-	if at < 0 || at > l.length {
-		panic(fmt.Sprintf("index out of range [%d] with length %d", at, l.length))
+func (l *SinglyLinkedList[T]) RemoveAt(at int) (T, error) {
+	if err := l.checkIndex(at, l.length); err != nil {
+		return *new(T), err
 	}
-	var x T
 	// handle a special case where we need to update the head of the list
 	if at == 0 {
-		x = *l.head.Data
-		l.head = l.head.next
-		l.length--
-		return x
+		x, _ := l.PopFront()
+		return x, nil
 	}
-	previous := l.head
-	for i := 1; i < at; i++ {
-		previous = previous.next
-	}
-	x = *previous.next.Data
-	previous.next = previous.next.next
-	l.length--
-	return x
+	return l.removeAfter(l.node(at - 1)), nil
 }
 
 // PopFront removes and returns the first element from the list.
@@ -148,33 +335,35 @@ func (l *SinglyLinkedList[T]) PopFront() (T, bool) {
 	if l.length == 0 {
 		return *new(T), false
 	}
-	x := *l.head.Data
-	l.head = l.head.next
+	removed := l.head
+	l.head = removed.next
 	l.length--
-	return x, true
+	if l.length == 0 {
+		l.tail = nil
+	}
+	detach(removed)
+	return removed.value, true
 }
 
 // PopBack removes and returns the last element from the list.
 // If the list is empty, it returns the zero value of type T and false.
-// Otherwise, it updates the last node's next pointer to nil, decrements the list's length,
+// Otherwise, it finds the node preceding the tail, makes it the new tail, decrements the list's length,
 // and returns the value of the removed node.
-// The time complexity of this operation is O(n), where n is the length of the list.
+// The time complexity of this operation is O(n), where n is the length of the list,
+// as the list has to be traversed to find the node preceding the tail.
 func (l *SinglyLinkedList[T]) PopBack() (T, bool) {
 	if l.length == 0 {
 		return *new(T), false
 	}
-	var x T
+	// handle a special case where the only node is removed
+	if l.length == 1 {
+		return l.PopFront()
+	}
 	previous := l.head
-	for n := l.head; n != nil; n = n.next {
-		if n.next == nil {
-			x = *n.Data
-			previous.next = nil
-			l.length--
-			break
-		}
-		previous = n
+	for previous.next != l.tail {
+		previous = previous.next
 	}
-	return x, true
+	return l.removeAfter(previous), true
 }
 
 // PushFront adds a new node with the given value to the front of the list.
@@ -184,34 +373,138 @@ func (l *SinglyLinkedList[T]) PopBack() (T, bool) {
 func (l *SinglyLinkedList[T]) PushFront(x T) {
 	// Create a new node with the given value and the current head as its next pointer
 	node := &Node[T]{
-		Data: &x,
-		next: l.head,
+		value: x,
+		next:  l.head,
+		owner: l.token(),
 	}
 	// Update the head of the list to the newly created node
 	l.head = node
+	// The first node of an empty list is also its last node
+	if l.length == 0 {
+		l.tail = node
+	}
 	// Increment the length of the list
 	l.length++
 }
 
 // PushBack adds a new node with the given value to the end of the list.
-// It traverses the list to find the last node and sets its next pointer to the new node.
-// The time complexity of this operation is O(n), where n is the length of the list.
+// It sets the next pointer of the tail to the new node and makes the new node the tail.
+// The time complexity of this operation is O(1), as it only requires updating the tail pointer.
 func (l *SinglyLinkedList[T]) PushBack(x T) {
 	node := &Node[T]{
-		Data: &x,
-		next: nil,
+		value: x,
+		next:  nil,
+		owner: l.token(),
 	}
 	// if the length is 0, it means that we insert a first node
 	if l.length == 0 {
 		l.head = node
 	} else {
-		// oterwise travers the list to find the end
-		for n := l.head; n != nil; n = n.next {
-			if n.next == nil {
-				n.next = node
-				break
-			}
-		}
+		l.tail.next = node
+	}
+	l.tail = node
+	l.length++
+}
+
+// node returns the node at the specified index, which must be in range.
+// The last node is returned directly from the tail pointer.
+func (l *SinglyLinkedList[T]) node(i int) *Node[T] {
+	if i == l.length-1 {
+		return l.tail
+	}
+	n := l.head
+	for range i {
+		n = n.next
+	}
+	return n
+}
+
+// checkIndex returns an error wrapping ErrIndexOutOfRange if i is not in the range [0, limit).
+func (l *SinglyLinkedList[T]) checkIndex(i, limit int) error {
+	if i < 0 || i >= limit {
+		return fmt.Errorf("%w [%d] with length %d", ErrIndexOutOfRange, i, l.length)
+	}
+	return nil
+}
+
+// InsertAfter inserts x right after the given node and returns the new node.
+// If the node does not belong to the list, the list is left unchanged and
+// nil and an error wrapping ErrForeignNode are returned.
+// The time complexity of this operation is O(1).
+func (l *SinglyLinkedList[T]) InsertAfter(n *Node[T], x T) (*Node[T], error) {
+	if err := l.checkNode(n); err != nil {
+		return nil, err
+	}
+	node := &Node[T]{value: x, next: n.next, owner: l.owner}
+	n.next = node
+	if n == l.tail {
+		l.tail = node
 	}
 	l.length++
+	return node, nil
+}
+
+// RemoveAfter removes and returns the element right after the given node.
+// If the node does not belong to the list, it returns the zero value of type T and an error wrapping ErrForeignNode.
+// If the node is the last one, it returns the zero value of type T and an error wrapping ErrIndexOutOfRange.
+// The time complexity of this operation is O(1).
+func (l *SinglyLinkedList[T]) RemoveAfter(n *Node[T]) (T, error) {
+	if err := l.checkNode(n); err != nil {
+		return *new(T), err
+	}
+	if n.next == nil {
+		return *new(T), fmt.Errorf("%w: no node after the last one", ErrIndexOutOfRange)
+	}
+	return l.removeAfter(n), nil
+}
+
+// MoveToFront moves the given node to the front of the list.
+// If the node does not belong to the list, the list is left unchanged and an error wrapping ErrForeignNode is returned.
+// The time complexity of this operation is O(n), where n is the length of the list,
+// as the list has to be traversed to find the node preceding the given one.
+func (l *SinglyLinkedList[T]) MoveToFront(n *Node[T]) error {
+	if err := l.checkNode(n); err != nil {
+		return err
+	}
+	if n == l.head {
+		return nil
+	}
+	previous := l.head
+	for previous.next != n {
+		previous = previous.next
+	}
+	previous.next = n.next
+	if n == l.tail {
+		l.tail = previous
+	}
+	n.next = l.head
+	l.head = n
+	return nil
+}
+
+// removeAfter removes the node following previous, which must exist, and returns its value.
+func (l *SinglyLinkedList[T]) removeAfter(previous *Node[T]) T {
+	removed := previous.next
+	previous.next = removed.next
+	if removed == l.tail {
+		l.tail = previous
+	}
+	l.length--
+	detach(removed)
+	return removed.value
+}
+
+// checkNode returns an error wrapping ErrForeignNode if n does not belong to the list.
+func (l *SinglyLinkedList[T]) checkNode(n *Node[T]) error {
+	if !l.owns(n) {
+		return ErrForeignNode
+	}
+	return nil
+}
+
+// detach dereferences the neighbour and the owner of a removed node
+// to allow GC to clean up and to make the node unusable with the list.
+func detach[T any](n *Node[T]) {
+	n.next = nil
+	n.owner = nil
 }
@@ -1,9 +1,37 @@
 package singlylinkedlist
 
 import (
+	"errors"
+	"slices"
 	"testing"
 )
 
+// assertInvariants checks that the head, tail and length of the list are consistent with its nodes
+// and that all nodes belong to the list.
+func assertInvariants[T any](t *testing.T, l *SinglyLinkedList[T]) {
+	t.Helper()
+	if l.length == 0 {
+		if l.head != nil || l.tail != nil {
+			t.Fatalf("empty list has head %p and tail %p, want nil", l.head, l.tail)
+		}
+		return
+	}
+	n, last := 0, l.head
+	for node := l.head; node != nil; node = node.next {
+		if !l.owns(node) {
+			t.Fatalf("node %d does not belong to the list", n)
+		}
+		n++
+		last = node
+	}
+	if n != l.length {
+		t.Fatalf("list has %d nodes, want length %d", n, l.length)
+	}
+	if l.tail != last {
+		t.Fatalf("tail is %p, want last node %p", l.tail, last)
+	}
+}
+
 func TestNewSinglyLinkedList(t *testing.T) {
 	l := NewSinglyLinkedList[int]()
 
@@ -23,11 +51,11 @@ func TestPushFront(t *testing.T) {
 	if l.Len() != 2 {
 		t.Errorf("expected length to be 2, got %d", l.length)
 	}
-	if *l.head.Data != 2 {
-		t.Errorf("expected head to be 2, got %d", *l.head.Data)
+	if l.head.Value() != 2 {
+		t.Errorf("expected head to be 2, got %d", l.head.Value())
 	}
-	if *l.head.next.Data != 1 {
-		t.Errorf("expected head.next to be 1, got %d", *l.head.next.Data)
+	if l.head.next.Value() != 1 {
+		t.Errorf("expected head.next to be 1, got %d", l.head.next.Value())
 	}
 }
 
@@ -39,11 +67,11 @@ func TestPushBack(t *testing.T) {
 	if l.Len() != 2 {
 		t.Errorf("expected length to be 2, got %d", l.length)
 	}
-	if *l.head.Data != 1 {
-		t.Errorf("expected head to be 1, got %d", *l.head.Data)
+	if l.head.Value() != 1 {
+		t.Errorf("expected head to be 1, got %d", l.head.Value())
 	}
-	if *l.head.next.Data != 2 {
-		t.Errorf("expected head.next to be 1, got %d", *l.head.next.Data)
+	if l.head.next.Value() != 2 {
+		t.Errorf("expected head.next to be 1, got %d", l.head.next.Value())
 	}
 }
 
@@ -143,14 +171,14 @@ func TestFront(t *testing.T) {
 	l.PushBack(2)
 
 	x, ok = l.Front()
-	if !ok || *x.Data != 1 {
-		t.Errorf("Front() = (%v, %t), want (1, true)", *x.Data, ok)
+	if !ok || x.Value() != 1 {
+		t.Errorf("Front() = (%v, %t), want (1, true)", x.Value(), ok)
 	}
 
 	_, _ = l.PopFront()
 	x, ok = l.Front()
-	if !ok || *x.Data != 2 {
-		t.Errorf("Front() = (%v, %t), want (2, true)", *x.Data, ok)
+	if !ok || x.Value() != 2 {
+		t.Errorf("Front() = (%v, %t), want (2, true)", x.Value(), ok)
 	}
 }
 
@@ -166,14 +194,14 @@ func TestBack(t *testing.T) {
 	l.PushBack(2)
 
 	x, ok = l.Back()
-	if !ok || *x.Data != 2 {
-		t.Errorf("Back() = (%v, %t), want (2, true)", *x.Data, ok)
+	if !ok || x.Value() != 2 {
+		t.Errorf("Back() = (%v, %t), want (2, true)", x.Value(), ok)
 	}
 
 	_, _ = l.PopBack()
 	x, ok = l.Back()
-	if !ok || *x.Data != 1 {
-		t.Errorf("Back() = (%v, %t), want (1, true)", *x.Data, ok)
+	if !ok || x.Value() != 1 {
+		t.Errorf("Back() = (%v, %t), want (1, true)", x.Value(), ok)
 	}
 }
 
@@ -225,6 +253,39 @@ func TestRemovePanic(t *testing.T) {
 	l.Remove(4)
 }
 
+func TestRemoveAt(t *testing.T) {
+	l := newList(1, 2, 3)
+	for _, at := range []int{-1, 3, 4} {
+		x, err := l.RemoveAt(at)
+		if !errors.Is(err, ErrIndexOutOfRange) || x != 0 {
+			t.Errorf("RemoveAt(%d) = (%v, %v), want (0, %v)", at, x, err, ErrIndexOutOfRange)
+		}
+	}
+	if _, err := l.RemoveAt(3); err == nil || err.Error() != "singlylinkedlist: index out of range [3] with length 3" {
+		t.Errorf("RemoveAt(3) error = %v, want index and length in the message", err)
+	}
+	assertInvariants(t, l)
+
+	x, err := l.RemoveAt(2)
+	if err != nil || x != 3 {
+		t.Errorf("RemoveAt(2) = (%v, %v), want (3, nil)", x, err)
+	}
+	assertInvariants(t, l)
+	if got := slices.Collect(l.Values()); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("values = %v, want [1 2]", got)
+	}
+}
+
+func TestRemovePanicAtLength(t *testing.T) {
+	defer func() {
+		r := recover()
+		if err, ok := r.(error); !ok || !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("Remove(Len()) panicked with %v, want %v", r, ErrIndexOutOfRange)
+		}
+	}()
+	newList(1, 2, 3).Remove(3)
+}
+
 func TestAppend(t *testing.T) {
 	type testCase struct {
 		name        string
@@ -298,13 +359,13 @@ func TestAppend(t *testing.T) {
 				if l1.Len() != 4 {
 					t.Errorf("expected length to be 4, got %d", l1.length)
 				}
-				if *l1.head.Data != 1 {
-					t.Errorf("expected head to be 1, got %d", *l1.head.Data)
+				if l1.head.Value() != 1 {
+					t.Errorf("expected head to be 1, got %d", l1.head.Value())
 				}
 
 				x, ok := l1.Back()
-				if !ok || *x.Data != 4 {
-					t.Errorf("Back() = (%v, %t), want (4, true)", *x.Data, ok)
+				if !ok || x.Value() != 4 {
+					t.Errorf("Back() = (%v, %t), want (4, true)", x.Value(), ok)
 				}
 			},
 		},
@@ -318,3 +379,497 @@ func TestAppend(t *testing.T) {
 		})
 	}
 }
+
+func TestTailConsistency(t *testing.T) {
+	l := NewSinglyLinkedList[int]()
+	assertInvariants(t, l)
+
+	l.PushFront(2)
+	assertInvariants(t, l)
+	l.PushFront(1)
+	assertInvariants(t, l)
+	l.PushBack(3)
+	assertInvariants(t, l)
+	if x, _ := l.Back(); x.Value() != 3 {
+		t.Errorf("Back() = %d, want 3", x.Value())
+	}
+
+	_, _ = l.PopBack()
+	assertInvariants(t, l)
+	if x, _ := l.Back(); x.Value() != 2 {
+		t.Errorf("Back() = %d, want 2", x.Value())
+	}
+
+	// removing the last element moves the tail back
+	l.PushBack(3)
+	l.Remove(2)
+	assertInvariants(t, l)
+	// removing the first element keeps the tail
+	l.Remove(0)
+	assertInvariants(t, l)
+	// removing the only element clears the tail
+	l.Remove(0)
+	assertInvariants(t, l)
+
+	l.PushBack(1)
+	_, _ = l.PopFront()
+	assertInvariants(t, l)
+	l.PushBack(1)
+	_, _ = l.PopBack()
+	assertInvariants(t, l)
+
+	other := NewSinglyLinkedList[int]()
+	other.PushBack(4)
+	other.PushBack(5)
+	l.Append(other)
+	assertInvariants(t, l)
+	l.PushBack(6)
+	assertInvariants(t, l)
+	l.Append(NewSinglyLinkedList[int]())
+	assertInvariants(t, l)
+	more := NewSinglyLinkedList[int]()
+	more.PushBack(7)
+	l.Append(more)
+	assertInvariants(t, l)
+	if x, _ := l.Back(); x.Value() != 7 {
+		t.Errorf("Back() = %d, want 7", x.Value())
+	}
+	if l.Len() != 4 {
+		t.Errorf("expected length to be 4, got %d", l.Len())
+	}
+
+	l.Clear()
+	assertInvariants(t, l)
+	l.PushBack(8)
+	assertInvariants(t, l)
+	if x, _ := l.Front(); x.Value() != 8 {
+		t.Errorf("Front() = %d, want 8", x.Value())
+	}
+}
+
+func BenchmarkPushBack(b *testing.B) {
+	b.ReportAllocs()
+	l := NewSinglyLinkedList[int]()
+	for i := range b.N {
+		l.PushBack(i)
+	}
+}
+
+func BenchmarkPushFront(b *testing.B) {
+	b.ReportAllocs()
+	l := NewSinglyLinkedList[int]()
+	for i := range b.N {
+		l.PushFront(i)
+	}
+}
+
+func TestPushAllocs(t *testing.T) {
+	l := NewSinglyLinkedList[int]()
+	// the element is stored in the node, so a push allocates only the node
+	if allocs := testing.AllocsPerRun(100, func() { l.PushBack(1) }); allocs != 1 {
+		t.Errorf("PushBack() allocates %v times, want 1", allocs)
+	}
+	if allocs := testing.AllocsPerRun(100, func() { l.PushFront(1) }); allocs != 1 {
+		t.Errorf("PushFront() allocates %v times, want 1", allocs)
+	}
+}
+
+func TestNodeValue(t *testing.T) {
+	l := newList(1, 2)
+	n, _ := l.Front()
+	if n.Value() != 1 {
+		t.Errorf("Value() = %d, want 1", n.Value())
+	}
+	n.Set(10)
+	if x, _ := l.Get(0); x != 10 {
+		t.Errorf("Get(0) after Set(10) = %d, want 10", x)
+	}
+
+	// the deprecated accessor shares the element with the node
+	p := n.Data()
+	*p = 20
+	if n.Value() != 20 {
+		t.Errorf("Value() after writing through Data() = %d, want 20", n.Value())
+	}
+}
+
+func newList(xs ...int) *SinglyLinkedList[int] {
+	l := NewSinglyLinkedList[int]()
+	for _, x := range xs {
+		l.PushBack(x)
+	}
+	return l
+}
+
+func TestAll(t *testing.T) {
+	l := newList(10, 20, 30)
+	var indices, got []int
+	for i, x := range l.All() {
+		indices = append(indices, i)
+		got = append(got, x)
+	}
+	if !slices.Equal(indices, []int{0, 1, 2}) {
+		t.Errorf("indices = %v, want [0 1 2]", indices)
+	}
+	if !slices.Equal(got, []int{10, 20, 30}) {
+		t.Errorf("values = %v, want [10 20 30]", got)
+	}
+
+	for range NewSinglyLinkedList[int]().All() {
+		t.Errorf("All() of an empty list yielded an element")
+	}
+
+	// stop early
+	got = nil
+	for _, x := range l.All() {
+		if x == 20 {
+			break
+		}
+		got = append(got, x)
+	}
+	if !slices.Equal(got, []int{10}) {
+		t.Errorf("values before break = %v, want [10]", got)
+	}
+}
+
+func TestAllRemoveCurrent(t *testing.T) {
+	l := newList(1, 2, 3, 4, 5, 6)
+	var visited []int
+	for i, x := range l.All() {
+		visited = append(visited, x)
+		if x%2 == 0 || x == 1 {
+			if removed := l.Remove(i); removed != x {
+				t.Fatalf("Remove(%d) = %d, want %d", i, removed, x)
+			}
+			assertInvariants(t, l)
+		}
+	}
+	if !slices.Equal(visited, []int{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("visited = %v, want [1 2 3 4 5 6]", visited)
+	}
+	if got := slices.Collect(l.Values()); !slices.Equal(got, []int{3, 5}) {
+		t.Errorf("values = %v, want [3 5]", got)
+	}
+}
+
+func TestValues(t *testing.T) {
+	l := newList(1, 2, 3)
+	if got := slices.Collect(l.Values()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Values() = %v, want [1 2 3]", got)
+	}
+
+	// removing the current element during iteration
+	var visited []int
+	for x := range l.Values() {
+		visited = append(visited, x)
+		_, _ = l.PopFront()
+	}
+	if !slices.Equal(visited, []int{1, 2, 3}) {
+		t.Errorf("visited = %v, want [1 2 3]", visited)
+	}
+	if l.Len() != 0 {
+		t.Errorf("expected length to be 0, got %d", l.Len())
+	}
+}
+
+func TestNodes(t *testing.T) {
+	l := newList(1, 2, 3)
+	for n := range l.Nodes() {
+		n.Set(n.Value() * 10)
+	}
+	if got := slices.Collect(l.Values()); !slices.Equal(got, []int{10, 20, 30}) {
+		t.Errorf("Values() = %v, want [10 20 30]", got)
+	}
+
+	// removing the last node during iteration
+	var visited []int
+	for n := range l.Nodes() {
+		visited = append(visited, n.Value())
+		if n.Value() == 30 {
+			_, _ = l.PopBack()
+		}
+	}
+	if !slices.Equal(visited, []int{10, 20, 30}) {
+		t.Errorf("visited = %v, want [10 20 30]", visited)
+	}
+	assertInvariants(t, l)
+}
+
+func TestGet(t *testing.T) {
+	l := newList(10, 20, 30)
+	type testCase struct {
+		i    int
+		want int
+		ok   bool
+	}
+	for _, tc := range []testCase{
+		{i: 0, want: 10, ok: true},
+		{i: 1, want: 20, ok: true},
+		{i: 2, want: 30, ok: true},
+		{i: 3, want: 0, ok: false},
+		{i: -1, want: 0, ok: false},
+	} {
+		x, err := l.Get(tc.i)
+		if x != tc.want || (err == nil) != tc.ok {
+			t.Errorf("Get(%d) = (%v, %v), want (%v, ok %t)", tc.i, x, err, tc.want, tc.ok)
+		}
+		if !tc.ok && !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("Get(%d) error = %v, want %v", tc.i, err, ErrIndexOutOfRange)
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	l := newList(1, 2, 3)
+	for i := range 3 {
+		if err := l.Set(i, (i+1)*10); err != nil {
+			t.Errorf("Set(%d) = %v, want nil", i, err)
+		}
+	}
+	for _, i := range []int{-1, 3} {
+		if err := l.Set(i, 0); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("Set(%d) = %v, want %v", i, err, ErrIndexOutOfRange)
+		}
+	}
+	assertInvariants(t, l)
+	if got := slices.Collect(l.Values()); !slices.Equal(got, []int{10, 20, 30}) {
+		t.Errorf("values = %v, want [10 20 30]", got)
+	}
+}
+
+func TestInsert(t *testing.T) {
+	type testCase struct {
+		name string
+		list []int
+		i    int
+		ok   bool
+		want []int
+	}
+	testCases := []testCase{
+		{name: "empty", list: nil, i: 0, ok: true, want: []int{9}},
+		{name: "front", list: []int{1, 2}, i: 0, ok: true, want: []int{9, 1, 2}},
+		{name: "middle", list: []int{1, 2, 3}, i: 2, ok: true, want: []int{1, 2, 9, 3}},
+		{name: "back", list: []int{1, 2}, i: 2, ok: true, want: []int{1, 2, 9}},
+		{name: "past the end", list: []int{1, 2}, i: 3, ok: false, want: []int{1, 2}},
+		{name: "negative", list: []int{1, 2}, i: -1, ok: false, want: []int{1, 2}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := newList(tc.list...)
+			err := l.Insert(tc.i, 9)
+			if (err == nil) != tc.ok {
+				t.Errorf("Insert(%d) = %v, want ok %t", tc.i, err, tc.ok)
+			}
+			if !tc.ok && !errors.Is(err, ErrIndexOutOfRange) {
+				t.Errorf("Insert(%d) error = %v, want %v", tc.i, err, ErrIndexOutOfRange)
+			}
+			assertInvariants(t, l)
+			if got := slices.Collect(l.Values()); !slices.Equal(got, tc.want) {
+				t.Errorf("values = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	l := newList(1, 2, 3, 2, 1)
+	eq := func(x1, x2 int) bool { return x1 == x2 }
+	type testCase struct {
+		x     int
+		first int
+		last  int
+	}
+	for _, tc := range []testCase{
+		{x: 1, first: 0, last: 4},
+		{x: 2, first: 1, last: 3},
+		{x: 3, first: 2, last: 2},
+		{x: 4, first: -1, last: -1},
+	} {
+		if i := l.IndexOf(tc.x, eq); i != tc.first {
+			t.Errorf("IndexOf(%d) = %d, want %d", tc.x, i, tc.first)
+		}
+		if i := l.LastIndexOf(tc.x, eq); i != tc.last {
+			t.Errorf("LastIndexOf(%d) = %d, want %d", tc.x, i, tc.last)
+		}
+	}
+	if i := l.IndexFunc(func(x int) bool { return x > 2 }); i != 2 {
+		t.Errorf("IndexFunc(x > 2) = %d, want 2", i)
+	}
+	if i := NewSinglyLinkedList[int]().IndexFunc(func(int) bool { return true }); i != -1 {
+		t.Errorf("IndexFunc() of an empty list = %d, want -1", i)
+	}
+}
+
+func TestAppendMovesNodes(t *testing.T) {
+	l, other := newList(1, 2), newList(3, 4)
+	moved, _ := other.Front()
+	l.Append(other)
+	assertInvariants(t, l)
+	assertInvariants(t, other)
+	if other.Len() != 0 {
+		t.Errorf("expected length of other to be 0, got %d", other.Len())
+	}
+	if got := slices.Collect(l.Values()); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("values = %v, want [1 2 3 4]", got)
+	}
+
+	// the moved nodes belong to the list now and the other list can be reused independently
+	if _, err := l.InsertAfter(moved, 30); err != nil {
+		t.Errorf("InsertAfter() of a moved node = %v, want nil", err)
+	}
+	if _, err := other.InsertAfter(moved, 30); !errors.Is(err, ErrForeignNode) {
+		t.Errorf("InsertAfter() of a moved node into the other list = %v, want %v", err, ErrForeignNode)
+	}
+	other.PushBack(5)
+	assertInvariants(t, l)
+	assertInvariants(t, other)
+	if got := slices.Collect(l.Values()); !slices.Equal(got, []int{1, 2, 3, 30, 4}) {
+		t.Errorf("values = %v, want [1 2 3 30 4]", got)
+	}
+
+	// ownership follows the nodes through repeated moves
+	third := newList(6)
+	third.Append(l)
+	assertInvariants(t, third)
+	if err := third.MoveToFront(moved); err != nil {
+		t.Errorf("MoveToFront() of a node moved twice = %v, want nil", err)
+	}
+	assertInvariants(t, third)
+}
+
+func TestAppendAllocs(t *testing.T) {
+	l := newList(1)
+	others := make([]*SinglyLinkedList[int], 100)
+	for i := range others {
+		others[i] = newList(1, 2, 3)
+	}
+	i := 0
+	// appending only links the lists, regardless of their length
+	if allocs := testing.AllocsPerRun(len(others)-1, func() {
+		l.Append(others[i])
+		i++
+	}); allocs != 0 {
+		t.Errorf("Append() allocates %v times, want 0", allocs)
+	}
+	assertInvariants(t, l)
+}
+
+func TestAppendPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	l := newList(1, 2)
+	l.Append(l)
+}
+
+func TestNodeNext(t *testing.T) {
+	l := newList(1, 2, 3)
+	var got []int
+	for n, _ := l.Front(); n != nil; n = n.Next() {
+		got = append(got, n.Value())
+	}
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("forward traversal = %v, want [1 2 3]", got)
+	}
+
+	front, _ := l.Front()
+	_, _ = l.PopFront()
+	if front.Next() != nil {
+		t.Errorf("Next() of a removed node = %v, want nil", front.Next())
+	}
+}
+
+func TestInsertAfter(t *testing.T) {
+	l := newList(1, 3)
+	front, _ := l.Front()
+	n, err := l.InsertAfter(front, 2)
+	if err != nil || n.Value() != 2 {
+		t.Fatalf("InsertAfter(1, 2) = (%v, %v), want (2, nil)", n, err)
+	}
+	assertInvariants(t, l)
+	back, _ := l.Back()
+	if _, err := l.InsertAfter(back, 4); err != nil {
+		t.Fatalf("InsertAfter(3, 4) = %v, want nil", err)
+	}
+	assertInvariants(t, l)
+	if got := slices.Collect(l.Values()); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("values = %v, want [1 2 3 4]", got)
+	}
+}
+
+func TestRemoveAfter(t *testing.T) {
+	l := newList(1, 2, 3)
+	front, _ := l.Front()
+	x, err := l.RemoveAfter(front)
+	if err != nil || x != 2 {
+		t.Errorf("RemoveAfter(1) = (%v, %v), want (2, nil)", x, err)
+	}
+	assertInvariants(t, l)
+	x, err = l.RemoveAfter(front)
+	if err != nil || x != 3 {
+		t.Errorf("RemoveAfter(1) = (%v, %v), want (3, nil)", x, err)
+	}
+	assertInvariants(t, l)
+	x, err = l.RemoveAfter(front)
+	if !errors.Is(err, ErrIndexOutOfRange) || x != 0 {
+		t.Errorf("RemoveAfter() of the last node = (%v, %v), want (0, %v)", x, err, ErrIndexOutOfRange)
+	}
+}
+
+func TestMoveToFront(t *testing.T) {
+	l := newList(1, 2, 3)
+	nodes := slices.Collect(l.Nodes())
+	type testCase struct {
+		node int
+		want []int
+	}
+	for _, tc := range []testCase{
+		{node: 1, want: []int{2, 1, 3}},
+		{node: 1, want: []int{2, 1, 3}},
+		{node: 2, want: []int{3, 2, 1}},
+		{node: 0, want: []int{1, 3, 2}},
+	} {
+		if err := l.MoveToFront(nodes[tc.node]); err != nil {
+			t.Fatalf("MoveToFront(%d) = %v, want nil", nodes[tc.node].Value(), err)
+		}
+		assertInvariants(t, l)
+		if got := slices.Collect(l.Values()); !slices.Equal(got, tc.want) {
+			t.Errorf("values after MoveToFront(%d) = %v, want %v", nodes[tc.node].Value(), got, tc.want)
+		}
+	}
+}
+
+func TestForeignNode(t *testing.T) {
+	l, other := newList(1, 2), newList(9)
+	foreign, _ := other.Front()
+	removed, _ := l.Front()
+	_, _ = l.PopFront()
+
+	for _, n := range []*Node[int]{foreign, removed, nil} {
+		if _, err := l.InsertAfter(n, 0); !errors.Is(err, ErrForeignNode) {
+			t.Errorf("InsertAfter() = %v, want %v", err, ErrForeignNode)
+		}
+		if _, err := l.RemoveAfter(n); !errors.Is(err, ErrForeignNode) {
+			t.Errorf("RemoveAfter() = %v, want %v", err, ErrForeignNode)
+		}
+		if err := l.MoveToFront(n); !errors.Is(err, ErrForeignNode) {
+			t.Errorf("MoveToFront() = %v, want %v", err, ErrForeignNode)
+		}
+	}
+	assertInvariants(t, l)
+	assertInvariants(t, other)
+	if l.Len() != 1 || other.Len() != 1 {
+		t.Errorf("lengths = %d and %d, want 1 and 1", l.Len(), other.Len())
+	}
+
+	// nodes moved to another list change their owner
+	if err := l.Splice(1, other); err != nil {
+		t.Fatalf("Splice() = %v, want nil", err)
+	}
+	if _, err := l.InsertAfter(foreign, 10); err != nil {
+		t.Errorf("InsertAfter() of a spliced node = %v, want nil", err)
+	}
+	assertInvariants(t, l)
+}
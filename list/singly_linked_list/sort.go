@@ -0,0 +1,102 @@
+package singlylinkedlist
+
+// Sort sorts the list in ascending order as determined by the cmp function,
+// which must return a negative number when a < b, a positive number when a > b and zero otherwise.
+// The sort is stable: equal elements keep their relative order.
+//
+// Sort is a bottom-up merge sort that relinks the existing nodes, so it does not allocate
+// and nodes obtained before sorting still hold the same values.
+// The time complexity of this operation is O(n*log(n)) and it uses O(1) extra memory,
+// where n is the length of the list.
+func (l *SinglyLinkedList[T]) Sort(cmp func(a, b T) int) {
+	for width := 1; width < l.length; width *= 2 {
+		var head, tail *Node[T]
+		for rest := l.head; rest != nil; {
+			left := rest
+			right := cut(left, width)
+			rest = cut(right, width)
+			h, t := merge(left, right, cmp)
+			if tail == nil {
+				head = h
+			} else {
+				tail.next = h
+			}
+			tail = t
+		}
+		l.head, l.tail = head, tail
+	}
+}
+
+// IsSorted reports whether the list is sorted in ascending order as determined by the cmp function.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func (l *SinglyLinkedList[T]) IsSorted(cmp func(a, b T) int) bool {
+	if l.length == 0 {
+		return true
+	}
+	for n := l.head; n.next != nil; n = n.next {
+		if cmp(n.value, n.next.value) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// MergeSorted merges the sorted list b into the sorted list a, so that a stays sorted
+// as determined by the cmp function, and leaves b empty.
+// The merge is stable: of equal elements, the ones from a come first.
+// The nodes of b are relinked into a, so no memory is allocated.
+//
+// It panics if a and b are the same list.
+// The time complexity of this operation is O(n+m), where n and m are the lengths of the lists.
+func MergeSorted[T any](a, b *SinglyLinkedList[T], cmp func(a, b T) int) {
+	if a == b {
+		panic("singlylinkedlist: cannot merge a list with itself")
+	}
+	if b.length == 0 {
+		return
+	}
+	a.handOver(b)
+	a.head, a.tail = merge(a.head, b.head, cmp)
+	a.length += b.length
+	b.head, b.tail, b.length = nil, nil, 0
+}
+
+// cut detaches the first k nodes of the chain starting at n and returns the head of the remaining nodes.
+// It returns nil if the chain has at most k nodes.
+func cut[T any](n *Node[T], k int) *Node[T] {
+	for i := 1; n != nil && i < k; i++ {
+		n = n.next
+	}
+	if n == nil {
+		return nil
+	}
+	rest := n.next
+	n.next = nil
+	return rest
+}
+
+// merge merges two sorted nil-terminated chains of nodes and returns the head and the tail of the result.
+// Of equal elements, the ones from a come first.
+func merge[T any](a, b *Node[T], cmp func(a, b T) int) (*Node[T], *Node[T]) {
+	var head, tail *Node[T]
+	// link is the pointer to be set to the next merged node; using it instead of
+	// a sentinel node keeps the merge free of allocations
+	link := &head
+	for a != nil && b != nil {
+		if cmp(a.value, b.value) <= 0 {
+			tail, a = a, a.next
+		} else {
+			tail, b = b, b.next
+		}
+		*link = tail
+		link = &tail.next
+	}
+	if a == nil {
+		a = b
+	}
+	*link = a
+	for ; a != nil; a = a.next {
+		tail = a
+	}
+	return head, tail
+}
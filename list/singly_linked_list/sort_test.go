@@ -0,0 +1,152 @@
+package singlylinkedlist
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// pair is used to check stability: only key takes part in comparisons.
+type pair struct {
+	key, seq int
+}
+
+func comparePairs(a, b pair) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+func TestSort(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, n := range []int{0, 1, 2, 3, 7, 8, 9, 100, 1025} {
+		l := NewSinglyLinkedList[pair]()
+		want := make([]pair, n)
+		for i := range n {
+			want[i] = pair{key: r.IntN(10), seq: i}
+			l.PushBack(want[i])
+		}
+		slices.SortStableFunc(want, comparePairs)
+
+		l.Sort(comparePairs)
+		assertInvariants(t, l)
+		if got := slices.Collect(l.Values()); !slices.Equal(got, want) {
+			t.Errorf("Sort() of %d elements = %v, want %v", n, got, want)
+		}
+		if !l.IsSorted(comparePairs) {
+			t.Errorf("IsSorted() after Sort() of %d elements = false, want true", n)
+		}
+		// the tail stays usable
+		l.PushBack(pair{key: 10, seq: n})
+		assertInvariants(t, l)
+	}
+}
+
+func TestSortKeepsNodes(t *testing.T) {
+	l := newList(3, 1, 2)
+	front, _ := l.Front()
+	l.Sort(cmp.Compare[int])
+	if front.Value() != 3 {
+		t.Errorf("node obtained before Sort() holds %d, want 3", front.Value())
+	}
+	if back, _ := l.Back(); back != front {
+		t.Errorf("Back() after Sort() is not the node holding 3")
+	}
+}
+
+func TestSortAllocs(t *testing.T) {
+	l := newList(5, 4, 3, 2, 1, 0)
+	allocs := testing.AllocsPerRun(10, func() {
+		l.Sort(func(a, b int) int { return cmp.Compare(b, a) })
+		l.Sort(cmp.Compare[int])
+	})
+	if allocs != 0 {
+		t.Errorf("Sort() allocates %v times, want 0", allocs)
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	type testCase struct {
+		list []int
+		want bool
+	}
+	for _, tc := range []testCase{
+		{list: nil, want: true},
+		{list: []int{1}, want: true},
+		{list: []int{1, 1, 2}, want: true},
+		{list: []int{2, 1}, want: false},
+		{list: []int{1, 2, 3, 0}, want: false},
+	} {
+		if got := newList(tc.list...).IsSorted(cmp.Compare[int]); got != tc.want {
+			t.Errorf("IsSorted(%v) = %t, want %t", tc.list, got, tc.want)
+		}
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	type testCase struct {
+		name string
+		a    []pair
+		b    []pair
+		want []pair
+	}
+	testCases := []testCase{
+		{name: "empty", a: nil, b: nil, want: nil},
+		{name: "empty a", a: nil, b: []pair{{1, 0}}, want: []pair{{1, 0}}},
+		{name: "empty b", a: []pair{{1, 0}}, b: nil, want: []pair{{1, 0}}},
+		{
+			name: "interleaved",
+			a:    []pair{{1, 0}, {3, 0}, {5, 0}},
+			b:    []pair{{2, 1}, {4, 1}, {6, 1}, {7, 1}},
+			want: []pair{{1, 0}, {2, 1}, {3, 0}, {4, 1}, {5, 0}, {6, 1}, {7, 1}},
+		},
+		{
+			name: "equal keys keep a first",
+			a:    []pair{{1, 0}, {2, 0}},
+			b:    []pair{{1, 1}, {2, 1}},
+			want: []pair{{1, 0}, {1, 1}, {2, 0}, {2, 1}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, b := NewSinglyLinkedList[pair](), NewSinglyLinkedList[pair]()
+			for _, x := range tc.a {
+				a.PushBack(x)
+			}
+			for _, x := range tc.b {
+				b.PushBack(x)
+			}
+			MergeSorted(a, b, comparePairs)
+			assertInvariants(t, a)
+			assertInvariants(t, b)
+			if got := slices.Collect(a.Values()); !slices.Equal(got, tc.want) {
+				t.Errorf("MergeSorted() = %v, want %v", got, tc.want)
+			}
+			if b.Len() != 0 {
+				t.Errorf("expected length of b to be 0, got %d", b.Len())
+			}
+		})
+	}
+}
+
+func TestMergeSortedPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	l := newList(1, 2)
+	MergeSorted(l, l, cmp.Compare[int])
+}
+
+func BenchmarkSort(b *testing.B) {
+	r := rand.New(rand.NewPCG(1, 2))
+	l := NewSinglyLinkedList[int]()
+	for range 10000 {
+		l.PushBack(r.Int())
+	}
+	b.ResetTimer()
+	for range b.N {
+		l.Sort(func(a, b int) int { return cmp.Compare(b, a) })
+		l.Sort(cmp.Compare[int])
+	}
+}
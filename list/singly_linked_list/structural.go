@@ -0,0 +1,139 @@
+package singlylinkedlist
+
+import "fmt"
+
+// Reverse reverses the order of the elements of the list in place by relinking its nodes.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func (l *SinglyLinkedList[T]) Reverse() {
+	var previous *Node[T]
+	for n := l.head; n != nil; {
+		next := n.next
+		n.next = previous
+		previous, n = n, next
+	}
+	l.head, l.tail = l.tail, l.head
+}
+
+// Sublist removes the elements with indices in the range [from, to) from the list
+// and returns them as a new list, in the same order.
+// The nodes are moved to the new list rather than copied.
+// If the range is invalid or out of bounds, the list is left unchanged and
+// nil and an error wrapping ErrIndexOutOfRange are returned.
+// The time complexity of this operation is O(to).
+func (l *SinglyLinkedList[T]) Sublist(from, to int) (*SinglyLinkedList[T], error) {
+	if from < 0 || to > l.length || from > to {
+		return nil, fmt.Errorf("%w [%d:%d] with length %d", ErrIndexOutOfRange, from, to, l.length)
+	}
+	sub := NewSinglyLinkedList[T]()
+	if from == to {
+		return sub, nil
+	}
+	var previous *Node[T]
+	first := l.head
+	if from > 0 {
+		previous = l.node(from - 1)
+		first = previous.next
+	}
+	last := l.node(to - 1)
+	if previous == nil {
+		l.head = last.next
+	} else {
+		previous.next = last.next
+	}
+	if last == l.tail {
+		l.tail = previous
+	}
+	last.next = nil
+	l.length -= to - from
+
+	adopt(first, sub)
+	sub.head, sub.tail, sub.length = first, last, to-from
+	return sub, nil
+}
+
+// SplitAt splits the list into two lists: the first one with the elements with indices smaller than i
+// and the second one with the remaining elements. The nodes are moved to the new lists,
+// so the list itself is left empty.
+// If the index is out of range, the list is left unchanged and
+// nil, nil and an error wrapping ErrIndexOutOfRange are returned. An index equal to Len() is valid.
+// The time complexity of this operation is O(i), where i is the index.
+func (l *SinglyLinkedList[T]) SplitAt(i int) (*SinglyLinkedList[T], *SinglyLinkedList[T], error) {
+	if err := l.checkIndex(i, l.length+1); err != nil {
+		return nil, nil, err
+	}
+	// only the front nodes are visited; the back nodes keep the owner of the list, which passes to the back list
+	front, _ := l.Sublist(0, i)
+	back := &SinglyLinkedList[T]{head: l.head, tail: l.tail, length: l.length, owner: l.owner}
+	l.head, l.tail, l.length, l.owner = nil, nil, 0, nil
+	return front, back, nil
+}
+
+// Splice inserts all elements of the other list at the specified index, shifting the element at that index
+// and all following ones back. The nodes of the other list are moved rather than copied, so it is left empty.
+// An index equal to Len() appends the other list to the end of the list.
+// If the index is out of range, both lists are left unchanged and an error wrapping ErrIndexOutOfRange is returned.
+//
+// It panics if other is the list itself.
+// The time complexity of this operation is O(i), where i is the index.
+func (l *SinglyLinkedList[T]) Splice(i int, other *SinglyLinkedList[T]) error {
+	if l == other {
+		panic("singlylinkedlist: cannot splice a list into itself")
+	}
+	if err := l.checkIndex(i, l.length+1); err != nil {
+		return err
+	}
+	if other.length == 0 {
+		return nil
+	}
+	l.handOver(other)
+	if i == 0 {
+		other.tail.next = l.head
+		l.head = other.head
+		if l.length == 0 {
+			l.tail = other.tail
+		}
+	} else {
+		previous := l.node(i - 1)
+		other.tail.next = previous.next
+		previous.next = other.head
+		if previous == l.tail {
+			l.tail = other.tail
+		}
+	}
+	l.length += other.length
+	other.head, other.tail, other.length = nil, nil, 0
+	return nil
+}
+
+// RotateLeft rotates the list k positions to the left, so that the element at index k becomes the first one.
+// k is taken modulo the length of the list and a negative k rotates the list to the right.
+// The time complexity of this operation is O(k mod n), where n is the length of the list.
+func (l *SinglyLinkedList[T]) RotateLeft(k int) {
+	if l.length == 0 {
+		return
+	}
+	k %= l.length
+	if k < 0 {
+		k += l.length
+	}
+	if k == 0 {
+		return
+	}
+	// close the list into a ring and cut it behind the new tail
+	tail := l.node(k - 1)
+	l.tail.next = l.head
+	l.head = tail.next
+	tail.next = nil
+	l.tail = tail
+}
+
+// RotateRight rotates the list k positions to the right, so that the last k elements become the first ones.
+// k is taken modulo the length of the list and a negative k rotates the list to the left.
+// The time complexity of this operation is O(n), where n is the length of the list,
+// as the new tail has to be found by traversing the list from the front.
+func (l *SinglyLinkedList[T]) RotateRight(k int) {
+	if l.length == 0 {
+		return
+	}
+	l.RotateLeft(l.length - k%l.length)
+}
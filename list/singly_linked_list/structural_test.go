@@ -0,0 +1,177 @@
+package singlylinkedlist
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestReverse(t *testing.T) {
+	for _, xs := range [][]int{nil, {1}, {1, 2}, {1, 2, 3, 4, 5}} {
+		l := newList(xs...)
+		l.Reverse()
+		assertInvariants(t, l)
+		want := slices.Clone(xs)
+		slices.Reverse(want)
+		if got := slices.Collect(l.Values()); !slices.Equal(got, want) {
+			t.Errorf("Reverse(%v) = %v, want %v", xs, got, want)
+		}
+	}
+}
+
+func TestSublist(t *testing.T) {
+	type testCase struct {
+		name     string
+		from, to int
+		sub      []int
+		left     []int
+	}
+	testCases := []testCase{
+		{name: "empty range", from: 2, to: 2, sub: nil, left: []int{0, 1, 2, 3, 4}},
+		{name: "prefix", from: 0, to: 2, sub: []int{0, 1}, left: []int{2, 3, 4}},
+		{name: "middle", from: 1, to: 4, sub: []int{1, 2, 3}, left: []int{0, 4}},
+		{name: "suffix", from: 3, to: 5, sub: []int{3, 4}, left: []int{0, 1, 2}},
+		{name: "whole list", from: 0, to: 5, sub: []int{0, 1, 2, 3, 4}, left: nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := newList(0, 1, 2, 3, 4)
+			sub, err := l.Sublist(tc.from, tc.to)
+			if err != nil {
+				t.Fatalf("Sublist(%d, %d) = %v, want nil", tc.from, tc.to, err)
+			}
+			assertInvariants(t, l)
+			assertInvariants(t, sub)
+			if got := slices.Collect(sub.Values()); !slices.Equal(got, tc.sub) {
+				t.Errorf("Sublist(%d, %d) = %v, want %v", tc.from, tc.to, got, tc.sub)
+			}
+			if got := slices.Collect(l.Values()); !slices.Equal(got, tc.left) {
+				t.Errorf("values = %v, want %v", got, tc.left)
+			}
+		})
+	}
+
+	l := newList(0, 1, 2)
+	for _, r := range [][2]int{{-1, 1}, {0, 4}, {2, 1}} {
+		if sub, err := l.Sublist(r[0], r[1]); sub != nil || !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("Sublist(%d, %d) = (%v, %v), want (nil, %v)", r[0], r[1], sub, err, ErrIndexOutOfRange)
+		}
+	}
+	assertInvariants(t, l)
+}
+
+func TestSplitAt(t *testing.T) {
+	for i := range 4 {
+		l := newList(0, 1, 2)
+		front, back, err := l.SplitAt(i)
+		if err != nil {
+			t.Fatalf("SplitAt(%d) = %v, want nil", i, err)
+		}
+		assertInvariants(t, l)
+		assertInvariants(t, front)
+		assertInvariants(t, back)
+		if l.Len() != 0 {
+			t.Errorf("expected length to be 0, got %d", l.Len())
+		}
+		if got, want := slices.Collect(front.Values()), []int{0, 1, 2}[:i]; !slices.Equal(got, want) {
+			t.Errorf("SplitAt(%d) front = %v, want %v", i, got, want)
+		}
+		if got, want := slices.Collect(back.Values()), []int{0, 1, 2}[i:]; !slices.Equal(got, want) {
+			t.Errorf("SplitAt(%d) back = %v, want %v", i, got, want)
+		}
+	}
+
+	l := newList(0, 1, 2)
+	if _, _, err := l.SplitAt(4); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("SplitAt(4) = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	if l.Len() != 3 {
+		t.Errorf("expected length to be 3, got %d", l.Len())
+	}
+}
+
+func TestSplice(t *testing.T) {
+	type testCase struct {
+		name  string
+		list  []int
+		other []int
+		i     int
+		want  []int
+	}
+	testCases := []testCase{
+		{name: "into empty", list: nil, other: []int{8, 9}, i: 0, want: []int{8, 9}},
+		{name: "empty other", list: []int{1, 2}, other: nil, i: 1, want: []int{1, 2}},
+		{name: "front", list: []int{1, 2}, other: []int{8, 9}, i: 0, want: []int{8, 9, 1, 2}},
+		{name: "middle", list: []int{1, 2}, other: []int{8, 9}, i: 1, want: []int{1, 8, 9, 2}},
+		{name: "back", list: []int{1, 2}, other: []int{8, 9}, i: 2, want: []int{1, 2, 8, 9}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l, other := newList(tc.list...), newList(tc.other...)
+			if err := l.Splice(tc.i, other); err != nil {
+				t.Fatalf("Splice(%d) = %v, want nil", tc.i, err)
+			}
+			assertInvariants(t, l)
+			assertInvariants(t, other)
+			if got := slices.Collect(l.Values()); !slices.Equal(got, tc.want) {
+				t.Errorf("values = %v, want %v", got, tc.want)
+			}
+			if other.Len() != 0 {
+				t.Errorf("expected length of other to be 0, got %d", other.Len())
+			}
+		})
+	}
+
+	l, other := newList(1, 2), newList(9)
+	if err := l.Splice(3, other); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Splice(3) = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	if l.Len() != 2 || other.Len() != 1 {
+		t.Errorf("lengths = %d and %d, want 2 and 1", l.Len(), other.Len())
+	}
+}
+
+func TestSplicePanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	l := newList(1, 2)
+	_ = l.Splice(0, l)
+}
+
+func TestRotate(t *testing.T) {
+	type testCase struct {
+		k     int
+		left  []int
+		right []int
+	}
+	for _, tc := range []testCase{
+		{k: 0, left: []int{0, 1, 2, 3, 4}, right: []int{0, 1, 2, 3, 4}},
+		{k: 1, left: []int{1, 2, 3, 4, 0}, right: []int{4, 0, 1, 2, 3}},
+		{k: 4, left: []int{4, 0, 1, 2, 3}, right: []int{1, 2, 3, 4, 0}},
+		{k: 5, left: []int{0, 1, 2, 3, 4}, right: []int{0, 1, 2, 3, 4}},
+		{k: 12, left: []int{2, 3, 4, 0, 1}, right: []int{3, 4, 0, 1, 2}},
+		{k: -1, left: []int{4, 0, 1, 2, 3}, right: []int{1, 2, 3, 4, 0}},
+	} {
+		l := newList(0, 1, 2, 3, 4)
+		l.RotateLeft(tc.k)
+		assertInvariants(t, l)
+		if got := slices.Collect(l.Values()); !slices.Equal(got, tc.left) {
+			t.Errorf("RotateLeft(%d) = %v, want %v", tc.k, got, tc.left)
+		}
+
+		l = newList(0, 1, 2, 3, 4)
+		l.RotateRight(tc.k)
+		assertInvariants(t, l)
+		if got := slices.Collect(l.Values()); !slices.Equal(got, tc.right) {
+			t.Errorf("RotateRight(%d) = %v, want %v", tc.k, got, tc.right)
+		}
+	}
+
+	empty := NewSinglyLinkedList[int]()
+	empty.RotateLeft(3)
+	empty.RotateRight(3)
+	assertInvariants(t, empty)
+}
@@ -0,0 +1,343 @@
+package singlylinkedlist
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncSinglyLinkedList is a singly linked list that is safe for concurrent use by multiple goroutines.
+// Every method locks the list for its whole duration, so each of them, including the compound
+// operations like PushBackIfAbsent and PopFrontIf, is atomic. Methods that only read the list
+// take a read lock and can run in parallel.
+//
+// The methods never hand out nodes, as a node could be modified by another goroutine once the lock
+// is released. For that reason the node-level operations (Nodes, InsertAfter, RemoveAfter and MoveToFront)
+// have no wrappers, and Front and Back return values instead of nodes. The package-level functions,
+// such as Map, Filter or MergeSorted, take plain lists and are not wrapped either. All of them can be
+// used atomically through Do and View.
+//
+// The methods that take or return other lists (Append, Splice, Sublist and SplitAt) lock only this list.
+// The other lists are plain SinglyLinkedLists that must not be used concurrently during the call.
+//
+// The zero value is an empty list ready to use. A SyncSinglyLinkedList must not be copied after first use.
+type SyncSinglyLinkedList[T any] struct {
+	mu   sync.RWMutex
+	list SinglyLinkedList[T]
+}
+
+// NewSyncSinglyLinkedList creates and returns a new instance of an empty thread-safe singly linked list.
+//
+// Example usage:
+//
+//	list := singlylinkedlist.NewSyncSinglyLinkedList[int]()
+func NewSyncSinglyLinkedList[T any]() *SyncSinglyLinkedList[T] {
+	return &SyncSinglyLinkedList[T]{}
+}
+
+// Do calls f with the underlying list while holding the write lock, so that f can perform
+// any sequence of operations atomically. The list and its nodes must not be used after f returns,
+// and f must not call methods of the SyncSinglyLinkedList, as it would deadlock.
+func (s *SyncSinglyLinkedList[T]) Do(f func(l *SinglyLinkedList[T])) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f(&s.list)
+}
+
+// View calls f with the underlying list while holding the read lock.
+// f must not modify the list; otherwise the same rules as for Do apply.
+func (s *SyncSinglyLinkedList[T]) View(f func(l *SinglyLinkedList[T])) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	f(&s.list)
+}
+
+// Len returns the number of elements in the list.
+func (s *SyncSinglyLinkedList[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Len()
+}
+
+// Clear removes all elements from the list.
+func (s *SyncSinglyLinkedList[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Clear()
+}
+
+// Contains checks if the list contains a specific element based on the provided equality function.
+func (s *SyncSinglyLinkedList[T]) Contains(x T, eq func(T, T) bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Contains(x, eq)
+}
+
+// Front returns the first element of the list and true.
+// If the list is empty, it returns the zero value of type T and false.
+func (s *SyncSinglyLinkedList[T]) Front() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	n, ok := s.list.Front()
+	if !ok {
+		return *new(T), false
+	}
+	return n.value, true
+}
+
+// Back returns the last element of the list and true.
+// If the list is empty, it returns the zero value of type T and false.
+func (s *SyncSinglyLinkedList[T]) Back() (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	n, ok := s.list.Back()
+	if !ok {
+		return *new(T), false
+	}
+	return n.value, true
+}
+
+// Get returns the element at the specified index.
+// If the index is out of range, it returns the zero value of type T and an error wrapping ErrIndexOutOfRange.
+func (s *SyncSinglyLinkedList[T]) Get(i int) (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.Get(i)
+}
+
+// Set replaces the element at the specified index with x.
+// If the index is out of range, it returns an error wrapping ErrIndexOutOfRange.
+func (s *SyncSinglyLinkedList[T]) Set(i int, x T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Set(i, x)
+}
+
+// Insert inserts x at the specified index.
+// If the index is out of range, it returns an error wrapping ErrIndexOutOfRange.
+func (s *SyncSinglyLinkedList[T]) Insert(i int, x T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Insert(i, x)
+}
+
+// IndexOf returns the index of the first element equal to x according to the provided equality function,
+// or -1 if there is no such element.
+func (s *SyncSinglyLinkedList[T]) IndexOf(x T, eq func(T, T) bool) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.IndexOf(x, eq)
+}
+
+// IndexFunc returns the index of the first element satisfying pred, or -1 if there is no such element.
+func (s *SyncSinglyLinkedList[T]) IndexFunc(pred func(T) bool) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.IndexFunc(pred)
+}
+
+// LastIndexOf returns the index of the last element equal to x according to the provided equality function,
+// or -1 if there is no such element.
+func (s *SyncSinglyLinkedList[T]) LastIndexOf(x T, eq func(T, T) bool) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.LastIndexOf(x, eq)
+}
+
+// PushFront adds x to the front of the list.
+func (s *SyncSinglyLinkedList[T]) PushFront(x T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.PushFront(x)
+}
+
+// PushBack adds x to the end of the list.
+func (s *SyncSinglyLinkedList[T]) PushBack(x T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.PushBack(x)
+}
+
+// PushBackIfAbsent adds x to the end of the list and returns true,
+// unless the list already contains an element equal to x according to the provided equality function,
+// in which case the list is left unchanged and false is returned.
+// The check and the insertion happen atomically.
+func (s *SyncSinglyLinkedList[T]) PushBackIfAbsent(x T, eq func(T, T) bool) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.list.Contains(x, eq) {
+		return false
+	}
+	s.list.PushBack(x)
+	return true
+}
+
+// Append moves all elements from the other list to the end of the list and leaves the other list empty.
+//
+// It panics if other is the underlying list.
+func (s *SyncSinglyLinkedList[T]) Append(other *SinglyLinkedList[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Append(other)
+}
+
+// Splice moves all elements of the other list to the specified index of the list and leaves the other list empty.
+// If the index is out of range, it returns an error wrapping ErrIndexOutOfRange.
+//
+// It panics if other is the underlying list.
+func (s *SyncSinglyLinkedList[T]) Splice(i int, other *SinglyLinkedList[T]) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Splice(i, other)
+}
+
+// Sublist removes the elements with indices in the range [from, to) from the list and returns them as a new list.
+// The returned list does not share any nodes with the list, so it can be used without locking.
+// If the range is invalid or out of bounds, it returns nil and an error wrapping ErrIndexOutOfRange.
+func (s *SyncSinglyLinkedList[T]) Sublist(from, to int) (*SinglyLinkedList[T], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Sublist(from, to)
+}
+
+// SplitAt moves the elements with indices smaller than i to the first returned list
+// and the remaining ones to the second, leaving the list empty.
+// The returned lists do not share any nodes with the list, so they can be used without locking.
+// If the index is out of range, it returns nil, nil and an error wrapping ErrIndexOutOfRange.
+func (s *SyncSinglyLinkedList[T]) SplitAt(i int) (*SinglyLinkedList[T], *SinglyLinkedList[T], error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.SplitAt(i)
+}
+
+// PopFront removes and returns the first element from the list and true.
+// If the list is empty, it returns the zero value of type T and false.
+func (s *SyncSinglyLinkedList[T]) PopFront() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.PopFront()
+}
+
+// PopFrontIf removes and returns the first element from the list and true if the element satisfies pred.
+// If the list is empty or its first element does not satisfy pred, the list is left unchanged and
+// the zero value of type T and false are returned.
+// The check and the removal happen atomically.
+func (s *SyncSinglyLinkedList[T]) PopFrontIf(pred func(T) bool) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n, ok := s.list.Front()
+	if !ok || !pred(n.value) {
+		return *new(T), false
+	}
+	return s.list.PopFront()
+}
+
+// PopBack removes and returns the last element from the list and true.
+// If the list is empty, it returns the zero value of type T and false.
+func (s *SyncSinglyLinkedList[T]) PopBack() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.PopBack()
+}
+
+// Remove removes and returns the element at the specified index from the list.
+// If the index is out of range, it panics with an error wrapping ErrIndexOutOfRange and the list stays usable.
+func (s *SyncSinglyLinkedList[T]) Remove(at int) T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.Remove(at)
+}
+
+// RemoveAt removes and returns the element at the specified index from the list.
+// If the index is out of range, it returns the zero value of type T and an error wrapping ErrIndexOutOfRange.
+func (s *SyncSinglyLinkedList[T]) RemoveAt(at int) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.RemoveAt(at)
+}
+
+// RemoveIf removes all elements satisfying pred from the list and returns the number of removed elements.
+func (s *SyncSinglyLinkedList[T]) RemoveIf(pred func(T) bool) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.RemoveIf(pred)
+}
+
+// RetainIf keeps only the elements satisfying pred in the list and returns the number of removed elements.
+func (s *SyncSinglyLinkedList[T]) RetainIf(pred func(T) bool) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list.RetainIf(pred)
+}
+
+// Sort sorts the list in ascending order as determined by the cmp function. The sort is stable.
+func (s *SyncSinglyLinkedList[T]) Sort(cmp func(a, b T) int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Sort(cmp)
+}
+
+// IsSorted reports whether the list is sorted in ascending order as determined by the cmp function.
+func (s *SyncSinglyLinkedList[T]) IsSorted(cmp func(a, b T) int) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.list.IsSorted(cmp)
+}
+
+// Reverse reverses the order of the elements of the list.
+func (s *SyncSinglyLinkedList[T]) Reverse() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.Reverse()
+}
+
+// RotateLeft rotates the list k positions to the left.
+func (s *SyncSinglyLinkedList[T]) RotateLeft(k int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.RotateLeft(k)
+}
+
+// RotateRight rotates the list k positions to the right.
+func (s *SyncSinglyLinkedList[T]) RotateRight(k int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list.RotateRight(k)
+}
+
+// Snapshot returns a copy of the elements of the list, from front to back, taken atomically.
+// The time complexity of this operation is O(n), where n is the length of the list.
+func (s *SyncSinglyLinkedList[T]) Snapshot() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	xs := make([]T, 0, s.list.Len())
+	for n := s.list.head; n != nil; n = n.next {
+		xs = append(xs, n.value)
+	}
+	return xs
+}
+
+// All returns an iterator over the index-value pairs of a snapshot of the list, taken when the iteration starts.
+// The lock is not held while the loop body runs, so the body may freely use the list;
+// such changes are not visible to the iteration.
+func (s *SyncSinglyLinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, x := range s.Snapshot() {
+			if !yield(i, x) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of a snapshot of the list, taken when the iteration starts.
+// The lock is not held while the loop body runs, so the body may freely use the list;
+// such changes are not visible to the iteration.
+func (s *SyncSinglyLinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, x := range s.Snapshot() {
+			if !yield(x) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package singlylinkedlist
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func eqInt(x1, x2 int) bool { return x1 == x2 }
+
+func TestSyncSinglyLinkedList(t *testing.T) {
+	var s SyncSinglyLinkedList[int]
+	s.PushBack(2)
+	s.PushFront(1)
+	s.PushBack(3)
+	if got := s.Snapshot(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Snapshot() = %v, want [1 2 3]", got)
+	}
+	if s.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", s.Len())
+	}
+	x, ok := s.Front()
+	if !ok || x != 1 {
+		t.Errorf("Front() = (%v, %t), want (1, true)", x, ok)
+	}
+	x, ok = s.Back()
+	if !ok || x != 3 {
+		t.Errorf("Back() = (%v, %t), want (3, true)", x, ok)
+	}
+	if x, err := s.Get(1); err != nil || x != 2 {
+		t.Errorf("Get(1) = (%v, %v), want (2, nil)", x, err)
+	}
+	if err := s.Insert(5, 0); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Insert(5) = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	if !s.Contains(3, eqInt) || s.IndexOf(3, eqInt) != 2 {
+		t.Errorf("Contains(3) = %t and IndexOf(3) = %d, want true and 2", s.Contains(3, eqInt), s.IndexOf(3, eqInt))
+	}
+
+	s.Reverse()
+	if s.IsSorted(cmp.Compare[int]) {
+		t.Errorf("IsSorted() of [3 2 1] = true, want false")
+	}
+	s.Sort(cmp.Compare[int])
+	if got := s.Snapshot(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Snapshot() after Sort() = %v, want [1 2 3]", got)
+	}
+
+	s.Do(func(l *SinglyLinkedList[int]) {
+		front, _ := l.Front()
+		_, _ = l.InsertAfter(front, 10)
+	})
+	s.View(func(l *SinglyLinkedList[int]) {
+		if got := slices.Collect(l.Values()); !slices.Equal(got, []int{1, 10, 2, 3}) {
+			t.Errorf("values = %v, want [1 10 2 3]", got)
+		}
+	})
+
+	s.Clear()
+	if x, ok := s.PopFront(); ok || x != 0 {
+		t.Errorf("PopFront() = (%v, %t), want (0, false)", x, ok)
+	}
+	if x, ok := s.Back(); ok || x != 0 {
+		t.Errorf("Back() = (%v, %t), want (0, false)", x, ok)
+	}
+}
+
+func TestSyncStructural(t *testing.T) {
+	s := NewSyncSinglyLinkedList[int]()
+	s.PushBack(1)
+	other := newList(2, 3)
+	s.Append(other)
+	if other.Len() != 0 {
+		t.Errorf("expected length of other to be 0, got %d", other.Len())
+	}
+	if err := s.Splice(0, newList(0)); err != nil {
+		t.Errorf("Splice(0) = %v, want nil", err)
+	}
+	if got := s.Snapshot(); !slices.Equal(got, []int{0, 1, 2, 3}) {
+		t.Errorf("Snapshot() = %v, want [0 1 2 3]", got)
+	}
+
+	sub, err := s.Sublist(1, 3)
+	if err != nil {
+		t.Fatalf("Sublist(1, 3) = %v, want nil", err)
+	}
+	assertInvariants(t, sub)
+	if got := slices.Collect(sub.Values()); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Sublist(1, 3) = %v, want [1 2]", got)
+	}
+	if x := s.Remove(1); x != 3 {
+		t.Errorf("Remove(1) = %d, want 3", x)
+	}
+
+	s.Append(sub)
+	front, back, err := s.SplitAt(1)
+	if err != nil {
+		t.Fatalf("SplitAt(1) = %v, want nil", err)
+	}
+	assertInvariants(t, front)
+	assertInvariants(t, back)
+	if got := slices.Collect(back.Values()); !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("SplitAt(1) back = %v, want [1 2]", got)
+	}
+	if s.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", s.Len())
+	}
+
+	// a panicking Remove releases the lock
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("Remove(0) of an empty list did not panic")
+			}
+		}()
+		s.Remove(0)
+	}()
+	s.PushBack(4)
+	if s.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", s.Len())
+	}
+}
+
+func TestPushBackIfAbsent(t *testing.T) {
+	s := NewSyncSinglyLinkedList[int]()
+	if !s.PushBackIfAbsent(1, eqInt) {
+		t.Errorf("PushBackIfAbsent(1) = false, want true")
+	}
+	if s.PushBackIfAbsent(1, eqInt) {
+		t.Errorf("PushBackIfAbsent(1) = true, want false")
+	}
+	if s.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", s.Len())
+	}
+}
+
+func TestPopFrontIf(t *testing.T) {
+	s := NewSyncSinglyLinkedList[int]()
+	if x, ok := s.PopFrontIf(isEven); ok || x != 0 {
+		t.Errorf("PopFrontIf() of an empty list = (%v, %t), want (0, false)", x, ok)
+	}
+	s.PushBack(2)
+	s.PushBack(3)
+	x, ok := s.PopFrontIf(isEven)
+	if !ok || x != 2 {
+		t.Errorf("PopFrontIf() = (%v, %t), want (2, true)", x, ok)
+	}
+	x, ok = s.PopFrontIf(isEven)
+	if ok || x != 0 {
+		t.Errorf("PopFrontIf() = (%v, %t), want (0, false)", x, ok)
+	}
+	if s.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", s.Len())
+	}
+}
+
+func TestSyncIterationWithoutLock(t *testing.T) {
+	s := NewSyncSinglyLinkedList[int]()
+	for i := range 3 {
+		s.PushBack(i)
+	}
+	var visited []int
+	for i, x := range s.All() {
+		// the body may modify the list; it does not deadlock and does not affect the iteration
+		s.PushBack(x + 10)
+		if i == 0 {
+			_, _ = s.PopFront()
+		}
+		visited = append(visited, x)
+	}
+	if !slices.Equal(visited, []int{0, 1, 2}) {
+		t.Errorf("visited = %v, want [0 1 2]", visited)
+	}
+	if got := slices.Collect(s.Values()); !slices.Equal(got, []int{1, 2, 10, 11, 12}) {
+		t.Errorf("Values() = %v, want [1 2 10 11 12]", got)
+	}
+}
+
+// TestSyncConcurrent checks that the compound operations are atomic under concurrent use.
+// Run it with the race detector to check the memory accesses as well.
+func TestSyncConcurrent(t *testing.T) {
+	const (
+		workers = 8
+		values  = 500
+	)
+	s := NewSyncSinglyLinkedList[int]()
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for x := range values {
+				s.PushBackIfAbsent(x, eqInt)
+				_ = s.Len()
+				for range s.Values() {
+					break
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	got := s.Snapshot()
+	slices.Sort(got)
+	if len(got) != values {
+		t.Fatalf("list has %d elements, want %d", len(got), values)
+	}
+	for i, x := range got {
+		if x != i {
+			t.Fatalf("elements mismatch at %d: got %d", i, x)
+		}
+	}
+
+	popped := make(chan int, values)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				x, ok := s.PopFrontIf(func(int) bool { return true })
+				if !ok {
+					return
+				}
+				popped <- x
+			}
+		}()
+	}
+	wg.Wait()
+	close(popped)
+	var all []int
+	for x := range popped {
+		all = append(all, x)
+	}
+	slices.Sort(all)
+	for i, x := range all {
+		if x != i {
+			t.Fatalf("popped elements mismatch at %d: got %d", i, x)
+		}
+	}
+	if len(all) != values {
+		t.Errorf("popped %d elements, want %d", len(all), values)
+	}
+}
@@ -0,0 +1,57 @@
+// Package stack implements a generic LIFO stack backed by a singly linked list.
+package stack
+
+import singlylinkedlist "github.com/GrzegorzMika/data-structures/list/singly_linked_list"
+
+// Stack is a last-in-first-out collection. Elements are pushed to and popped from the front
+// of the underlying list, so all operations are O(1). The zero value is an empty stack ready to use.
+type Stack[T any] struct {
+	list singlylinkedlist.SinglyLinkedList[T]
+}
+
+// NewStack creates and returns a new instance of an empty stack.
+//
+// Example usage:
+//
+//	s := stack.NewStack[int]()
+//	s.Push(1)
+//	x, ok := s.Pop()
+func NewStack[T any]() *Stack[T] {
+	return &Stack[T]{}
+}
+
+// Push adds x to the top of the stack.
+// The time complexity of this operation is O(1).
+func (s *Stack[T]) Push(x T) {
+	s.list.PushFront(x)
+}
+
+// Pop removes and returns the element at the top of the stack and true.
+// If the stack is empty, it returns the zero value of type T and false.
+// The time complexity of this operation is O(1).
+func (s *Stack[T]) Pop() (T, bool) {
+	return s.list.PopFront()
+}
+
+// Peek returns the element at the top of the stack and true without removing it.
+// If the stack is empty, it returns the zero value of type T and false.
+// The time complexity of this operation is O(1).
+func (s *Stack[T]) Peek() (T, bool) {
+	n, ok := s.list.Front()
+	if !ok {
+		return *new(T), false
+	}
+	return n.Value(), true
+}
+
+// Len returns the number of elements in the stack.
+// The time complexity of this operation is O(1).
+func (s *Stack[T]) Len() int {
+	return s.list.Len()
+}
+
+// IsEmpty reports whether the stack is empty.
+// The time complexity of this operation is O(1).
+func (s *Stack[T]) IsEmpty() bool {
+	return s.list.Len() == 0
+}
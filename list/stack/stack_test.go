@@ -0,0 +1,56 @@
+package stack
+
+import "testing"
+
+func TestNewStack(t *testing.T) {
+	s := NewStack[int]()
+	if !s.IsEmpty() || s.Len() != 0 {
+		t.Errorf("IsEmpty() = %t and Len() = %d, want true and 0", s.IsEmpty(), s.Len())
+	}
+	x, ok := s.Pop()
+	if ok || x != 0 {
+		t.Errorf("Pop() = (%v, %t), want (0, false)", x, ok)
+	}
+	x, ok = s.Peek()
+	if ok || x != 0 {
+		t.Errorf("Peek() = (%v, %t), want (0, false)", x, ok)
+	}
+}
+
+func TestLIFO(t *testing.T) {
+	s := NewStack[int]()
+	for i := range 5 {
+		s.Push(i)
+	}
+	if s.Len() != 5 {
+		t.Errorf("Len() = %d, want 5", s.Len())
+	}
+	for i := 4; i >= 0; i-- {
+		x, ok := s.Peek()
+		if !ok || x != i {
+			t.Errorf("Peek() = (%v, %t), want (%d, true)", x, ok, i)
+		}
+		x, ok = s.Pop()
+		if !ok || x != i {
+			t.Errorf("Pop() = (%v, %t), want (%d, true)", x, ok, i)
+		}
+	}
+	if !s.IsEmpty() {
+		t.Errorf("IsEmpty() = false, want true")
+	}
+}
+
+func TestZeroValueStack(t *testing.T) {
+	var s Stack[int]
+	if x, ok := s.Peek(); ok || x != 0 {
+		t.Errorf("Peek() = (%v, %t), want (0, false)", x, ok)
+	}
+	s.Push(1)
+	s.Push(2)
+	if x, ok := s.Pop(); !ok || x != 2 {
+		t.Errorf("Pop() = (%v, %t), want (2, true)", x, ok)
+	}
+	if s.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", s.Len())
+	}
+}
@@ -0,0 +1,144 @@
+// Package fairqueue implements a weighted fair queue.
+//
+// A FairQueue shares its output between tenants in proportion to their weights.
+// Every tenant has its own FIFO subqueue, and every enqueued item is stamped with a
+// virtual finish time: the virtual time at which the item would finish if each backlogged
+// tenant were served at a rate proportional to its weight. Dequeue always returns the item
+// with the smallest virtual finish time, found with a binary heap holding the head of every
+// non-empty subqueue.
+//
+// The virtual time is tracked with self-clocked fair queuing: it is the finish time of the
+// most recently dequeued item. While all tenants are backlogged, a tenant with weight w
+// receives w/W of the dequeued items, where W is the sum of the weights of the backlogged
+// tenants; an idle tenant does not accumulate credit it could later use to starve others.
+package fairqueue
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+
+	binaryheap "github.com/GrzegorzMika/data-structures/heap/binary-heap"
+	singlylinkedlist "github.com/GrzegorzMika/data-structures/list/singly_linked_list"
+)
+
+// ErrUnknownTenant is returned when an item is enqueued for a tenant that was not added to the queue.
+var ErrUnknownTenant = errors.New("fairqueue: unknown tenant")
+
+// TenantID identifies a tenant of a FairQueue.
+type TenantID int
+
+type item[T any] struct {
+	value  T
+	finish float64
+}
+
+type tenant[T any] struct {
+	weight float64
+	// lastFinish is the virtual finish time of the last item enqueued by the tenant
+	lastFinish float64
+	items      *singlylinkedlist.SinglyLinkedList[item[T]]
+}
+
+// head is the first item of a backlogged tenant, as stored in the heap.
+type head struct {
+	finish float64
+	tenant TenantID
+}
+
+type FairQueue[T any] struct {
+	tenants     []*tenant[T]
+	heads       *binaryheap.BinaryHeapFunc[head]
+	virtualTime float64
+	length      int
+}
+
+// NewFairQueue creates a new instance of FairQueue without any tenants.
+func NewFairQueue[T any]() *FairQueue[T] {
+	return &FairQueue[T]{
+		// the heap keeps the smallest finish time at the top; ties go to the tenant added first
+		heads: binaryheap.NewBinaryHeapFunc(func(a, b head) int {
+			if c := cmp.Compare(b.finish, a.finish); c != 0 {
+				return c
+			}
+			return cmp.Compare(b.tenant, a.tenant)
+		}),
+	}
+}
+
+// AddTenant adds a new tenant with the given weight and returns its ID.
+// Under backlog, each tenant receives a share of the dequeued items proportional to its weight.
+//
+// It panics if weight is not positive.
+func (q *FairQueue[T]) AddTenant(weight float64) TenantID {
+	if !(weight > 0) {
+		panic(fmt.Sprintf("fairqueue: tenant weight must be positive, got %v", weight))
+	}
+	q.tenants = append(q.tenants, &tenant[T]{
+		weight: weight,
+		items:  singlylinkedlist.NewSinglyLinkedList[item[T]](),
+	})
+	return TenantID(len(q.tenants) - 1)
+}
+
+// Len returns the number of items in the queue across all tenants.
+//
+// The time complexity of this method is O(1).
+func (q *FairQueue[T]) Len() int {
+	return q.length
+}
+
+// TenantLen returns the number of items waiting in the subqueue of the given tenant.
+// It returns 0 for unknown tenants.
+func (q *FairQueue[T]) TenantLen(id TenantID) int {
+	t, ok := q.tenant(id)
+	if !ok {
+		return 0
+	}
+	return t.items.Len()
+}
+
+// Enqueue adds the item to the end of the subqueue of the given tenant.
+// It returns ErrUnknownTenant if the tenant was not added to the queue.
+//
+// The time complexity of this method is O(log t), where t is the number of tenants.
+func (q *FairQueue[T]) Enqueue(id TenantID, x T) error {
+	t, ok := q.tenant(id)
+	if !ok {
+		return fmt.Errorf("%w: %d", ErrUnknownTenant, id)
+	}
+	finish := max(q.virtualTime, t.lastFinish) + 1/t.weight
+	t.lastFinish = finish
+	t.items.PushBack(item[T]{value: x, finish: finish})
+	if t.items.Len() == 1 {
+		q.heads.Push(head{finish: finish, tenant: id})
+	}
+	q.length++
+	return nil
+}
+
+// Dequeue removes and returns the item with the smallest virtual finish time and true.
+// If the queue is empty, it returns the zero value of type T and false.
+//
+// The time complexity of this method is O(log t), where t is the number of tenants.
+func (q *FairQueue[T]) Dequeue() (T, bool) {
+	h, ok := q.heads.Pop()
+	if !ok {
+		return *new(T), false
+	}
+	t := q.tenants[h.tenant]
+	it, _ := t.items.PopFront()
+	q.virtualTime = it.finish
+	if next, ok := t.items.Front(); ok {
+		q.heads.Push(head{finish: next.Value().finish, tenant: h.tenant})
+	}
+	q.length--
+	return it.value, true
+}
+
+func (q *FairQueue[T]) tenant(id TenantID) (*tenant[T], bool) {
+	if id < 0 || int(id) >= len(q.tenants) {
+		return nil, false
+	}
+	return q.tenants[id], true
+}
@@ -0,0 +1,143 @@
+package fairqueue
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestNewFairQueue(t *testing.T) {
+	q := NewFairQueue[string]()
+	if q.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", q.Len())
+	}
+	x, ok := q.Dequeue()
+	if ok || x != "" {
+		t.Errorf("Dequeue() = (%q, %t), want (\"\", false)", x, ok)
+	}
+}
+
+func TestAddTenantPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	NewFairQueue[int]().AddTenant(0)
+}
+
+func TestEnqueueUnknownTenant(t *testing.T) {
+	q := NewFairQueue[int]()
+	q.AddTenant(1)
+	for _, id := range []TenantID{-1, 1} {
+		if err := q.Enqueue(id, 1); !errors.Is(err, ErrUnknownTenant) {
+			t.Errorf("Enqueue(%d) error = %v, want %v", id, err, ErrUnknownTenant)
+		}
+	}
+	if q.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", q.Len())
+	}
+}
+
+func TestSingleTenantIsFIFO(t *testing.T) {
+	q := NewFairQueue[int]()
+	id := q.AddTenant(2)
+	for i := range 5 {
+		if err := q.Enqueue(id, i); err != nil {
+			t.Fatalf("Enqueue() error = %v", err)
+		}
+	}
+	if q.TenantLen(id) != 5 {
+		t.Errorf("TenantLen() = %d, want 5", q.TenantLen(id))
+	}
+	var got []int
+	for q.Len() > 0 {
+		x, _ := q.Dequeue()
+		got = append(got, x)
+	}
+	if want := []int{0, 1, 2, 3, 4}; !slices.Equal(got, want) {
+		t.Errorf("dequeued %v, want %v", got, want)
+	}
+}
+
+func TestEqualWeightsInterleave(t *testing.T) {
+	q := NewFairQueue[string]()
+	a := q.AddTenant(1)
+	b := q.AddTenant(1)
+	for range 3 {
+		_ = q.Enqueue(a, "a")
+	}
+	for range 3 {
+		_ = q.Enqueue(b, "b")
+	}
+	var got []string
+	for q.Len() > 0 {
+		x, _ := q.Dequeue()
+		got = append(got, x)
+	}
+	if want := []string{"a", "b", "a", "b", "a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("dequeued %v, want %v", got, want)
+	}
+}
+
+func TestThroughputProportionalToWeights(t *testing.T) {
+	q := NewFairQueue[TenantID]()
+	weights := []float64{1, 2, 3, 4}
+	ids := make([]TenantID, len(weights))
+	for i, w := range weights {
+		ids[i] = q.AddTenant(w)
+	}
+	// keep every tenant backlogged for the whole measurement
+	const backlog = 1000
+	for _, id := range ids {
+		for range backlog {
+			_ = q.Enqueue(id, id)
+		}
+	}
+
+	const served = 1000
+	counts := make([]int, len(ids))
+	for range served {
+		id, ok := q.Dequeue()
+		if !ok {
+			t.Fatalf("Dequeue() = (_, false), want (_, true)")
+		}
+		counts[id]++
+	}
+
+	total := 0.0
+	for _, w := range weights {
+		total += w
+	}
+	for i, w := range weights {
+		want := served * w / total
+		if math.Abs(float64(counts[i])-want) > 1 {
+			t.Errorf("tenant %d with weight %v served %d items, want %.0f", i, w, counts[i], want)
+		}
+	}
+}
+
+func TestIdleTenantDoesNotAccumulateCredit(t *testing.T) {
+	q := NewFairQueue[string]()
+	busy := q.AddTenant(1)
+	idle := q.AddTenant(1)
+	for range 100 {
+		_ = q.Enqueue(busy, "busy")
+	}
+	for range 50 {
+		_, _ = q.Dequeue()
+	}
+	// the idle tenant joins late and must share fairly instead of getting 50 items in a row
+	for range 10 {
+		_ = q.Enqueue(idle, "idle")
+	}
+	var got []string
+	for range 4 {
+		x, _ := q.Dequeue()
+		got = append(got, x)
+	}
+	if want := []string{"busy", "idle", "busy", "idle"}; !slices.Equal(got, want) {
+		t.Errorf("dequeued %v, want %v", got, want)
+	}
+}
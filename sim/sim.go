@@ -0,0 +1,171 @@
+// Package sim implements a discrete-event simulation engine.
+//
+// A Simulator keeps a virtual clock and a future-event list backed by a binary heap.
+// Events are executed in order of their scheduled time; events scheduled for the same
+// time are executed in the order in which they were scheduled. The clock only moves when
+// events are executed, so simulations are deterministic and never depend on wall-clock time.
+package sim
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+
+	binaryheap "github.com/GrzegorzMika/data-structures/heap/binary-heap"
+)
+
+// Event is an action executed by the simulator at its scheduled time.
+// The simulator passes itself to the event, so that the event can read the clock
+// and schedule or cancel other events.
+type Event func(s *Simulator)
+
+// EventID identifies a scheduled event. It can be used to cancel the event.
+type EventID uint64
+
+type entry struct {
+	at    time.Duration
+	seq   uint64
+	event Event
+}
+
+type Simulator struct {
+	now     time.Duration
+	seq     uint64
+	events  *binaryheap.BinaryHeapFunc[*entry]
+	pending map[EventID]*entry
+	stopped bool
+}
+
+// New creates a new instance of Simulator with the clock set to zero.
+// Simulation time is measured as the time.Duration elapsed since the start of the simulation.
+func New() *Simulator {
+	return &Simulator{
+		// the heap keeps the earliest event at the top; ties are broken by scheduling order
+		events: binaryheap.NewBinaryHeapFunc(func(a, b *entry) int {
+			if c := cmp.Compare(b.at, a.at); c != 0 {
+				return c
+			}
+			return cmp.Compare(b.seq, a.seq)
+		}),
+		pending: make(map[EventID]*entry),
+	}
+}
+
+// Now returns the current simulation time.
+func (s *Simulator) Now() time.Duration {
+	return s.now
+}
+
+// Pending returns the number of scheduled events that have not been executed or cancelled yet.
+func (s *Simulator) Pending() int {
+	return len(s.pending)
+}
+
+// Schedule schedules the event to be executed at the given simulation time and returns its ID.
+// Events scheduled for the same time are executed in the order in which they were scheduled.
+//
+// It panics if e is nil or if at is earlier than the current simulation time.
+//
+// The time complexity of this method is O(log n), where n is the number of scheduled events.
+func (s *Simulator) Schedule(at time.Duration, e Event) EventID {
+	if e == nil {
+		panic("sim: cannot schedule a nil event")
+	}
+	if at < s.now {
+		panic(fmt.Sprintf("sim: cannot schedule event at %v before current time %v", at, s.now))
+	}
+	s.seq++
+	en := &entry{at: at, seq: s.seq, event: e}
+	s.events.Push(en)
+	id := EventID(s.seq)
+	s.pending[id] = en
+	return id
+}
+
+// After schedules the event to be executed after the given delay and returns its ID.
+// It is a shorthand for Schedule(Now()+d, e).
+func (s *Simulator) After(d time.Duration, e Event) EventID {
+	return s.Schedule(s.now+d, e)
+}
+
+// Cancel cancels the scheduled event with the given ID.
+// It returns true if the event was pending and false if it has already been executed or cancelled.
+//
+// Cancelled events are removed from the future-event list lazily, when they reach its top.
+// The time complexity of this method is O(1).
+func (s *Simulator) Cancel(id EventID) bool {
+	en, ok := s.pending[id]
+	if !ok {
+		return false
+	}
+	en.event = nil
+	delete(s.pending, id)
+	return true
+}
+
+// Stop makes Run or RunUntil return after the currently executing event.
+// Events that have not been executed yet stay scheduled.
+func (s *Simulator) Stop() {
+	s.stopped = true
+}
+
+// Step executes the next scheduled event, advancing the clock to its time.
+// It returns false if there are no scheduled events.
+func (s *Simulator) Step() bool {
+	en, ok := s.next()
+	if !ok {
+		return false
+	}
+	s.execute(en)
+	return true
+}
+
+// Run executes the scheduled events in order until there are none left or Stop is called.
+func (s *Simulator) Run() {
+	s.stopped = false
+	for !s.stopped && s.Step() {
+	}
+}
+
+// RunUntil executes the events scheduled up to and including the given time, in order,
+// and then advances the clock to that time. Events scheduled after the given time stay scheduled.
+// If Stop is called, RunUntil returns immediately and the clock stays at the time of the last executed event.
+//
+// It panics if until is earlier than the current simulation time.
+func (s *Simulator) RunUntil(until time.Duration) {
+	if until < s.now {
+		panic(fmt.Sprintf("sim: cannot run until %v before current time %v", until, s.now))
+	}
+	s.stopped = false
+	for !s.stopped {
+		en, ok := s.next()
+		if !ok || en.at > until {
+			break
+		}
+		s.execute(en)
+	}
+	if !s.stopped {
+		s.now = until
+	}
+}
+
+// next discards cancelled events and returns the earliest pending event without removing it.
+func (s *Simulator) next() (*entry, bool) {
+	for {
+		en, ok := s.events.Peek()
+		if !ok {
+			return nil, false
+		}
+		if en.event != nil {
+			return en, true
+		}
+		_, _ = s.events.Pop()
+	}
+}
+
+func (s *Simulator) execute(en *entry) {
+	_, _ = s.events.Pop()
+	delete(s.pending, EventID(en.seq))
+	s.now = en.at
+	en.event(s)
+}
@@ -0,0 +1,203 @@
+package sim
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	s := New()
+	if s.Now() != 0 {
+		t.Errorf("Now() = %v, want 0", s.Now())
+	}
+	if s.Pending() != 0 {
+		t.Errorf("Pending() = %d, want 0", s.Pending())
+	}
+	if s.Step() {
+		t.Errorf("Step() = true, want false")
+	}
+}
+
+func TestScheduleOrdering(t *testing.T) {
+	s := New()
+	var got []string
+	record := func(name string) Event {
+		return func(s *Simulator) {
+			got = append(got, fmt.Sprintf("%s@%v", name, s.Now()))
+		}
+	}
+	s.Schedule(3*time.Second, record("c"))
+	s.Schedule(1*time.Second, record("a"))
+	s.Schedule(2*time.Second, record("b1"))
+	s.Schedule(2*time.Second, record("b2"))
+	s.Schedule(2*time.Second, record("b3"))
+	if s.Pending() != 5 {
+		t.Errorf("Pending() = %d, want 5", s.Pending())
+	}
+	s.Run()
+
+	want := []string{"a@1s", "b1@2s", "b2@2s", "b3@2s", "c@3s"}
+	if !slices.Equal(got, want) {
+		t.Errorf("executed %v, want %v", got, want)
+	}
+	if s.Now() != 3*time.Second {
+		t.Errorf("Now() = %v, want 3s", s.Now())
+	}
+	if s.Pending() != 0 {
+		t.Errorf("Pending() = %d, want 0", s.Pending())
+	}
+}
+
+func TestScheduleFromEvent(t *testing.T) {
+	s := New()
+	var ticks []time.Duration
+	var tick Event
+	n := 0
+	tick = func(s *Simulator) {
+		ticks = append(ticks, s.Now())
+		if n++; n < 4 {
+			s.After(10*time.Millisecond, tick)
+		}
+	}
+	s.Schedule(0, tick)
+	// an event scheduled at the current time runs after the events already scheduled for it
+	s.Schedule(0, func(s *Simulator) {
+		s.After(0, func(s *Simulator) { ticks = append(ticks, -1) })
+	})
+	s.Run()
+
+	want := []time.Duration{0, -1, 10 * time.Millisecond, 20 * time.Millisecond, 30 * time.Millisecond}
+	if !slices.Equal(ticks, want) {
+		t.Errorf("ticks = %v, want %v", ticks, want)
+	}
+}
+
+func TestSchedulePanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+
+	s := New()
+	s.Schedule(time.Second, func(*Simulator) {})
+	s.Run()
+	s.Schedule(time.Millisecond, func(*Simulator) {})
+}
+
+func TestScheduleNilPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+
+	s := New()
+	s.Schedule(5, nil)
+}
+
+func TestCancel(t *testing.T) {
+	s := New()
+	var got []int
+	ids := make([]EventID, 5)
+	for i := range ids {
+		ids[i] = s.Schedule(time.Duration(i)*time.Second, func(*Simulator) { got = append(got, i) })
+	}
+	if !s.Cancel(ids[1]) {
+		t.Errorf("Cancel(1) = false, want true")
+	}
+	if s.Cancel(ids[1]) {
+		t.Errorf("Cancel(1) = true after cancelling, want false")
+	}
+	// events can cancel other events
+	s.Schedule(2*time.Second, func(s *Simulator) { s.Cancel(ids[4]) })
+	if s.Pending() != 5 {
+		t.Errorf("Pending() = %d, want 5", s.Pending())
+	}
+	s.Run()
+
+	if want := []int{0, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("executed %v, want %v", got, want)
+	}
+	if s.Cancel(ids[0]) {
+		t.Errorf("Cancel(0) = true after execution, want false")
+	}
+	if s.Now() != 3*time.Second {
+		t.Errorf("Now() = %v, want 3s", s.Now())
+	}
+}
+
+func TestRunUntil(t *testing.T) {
+	s := New()
+	var got []time.Duration
+	for _, at := range []time.Duration{1, 2, 3, 5, 8} {
+		s.Schedule(at*time.Second, func(s *Simulator) { got = append(got, s.Now()) })
+	}
+
+	s.RunUntil(3 * time.Second)
+	if want := []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}; !slices.Equal(got, want) {
+		t.Errorf("executed %v, want %v", got, want)
+	}
+	if s.Now() != 3*time.Second {
+		t.Errorf("Now() = %v, want 3s", s.Now())
+	}
+
+	s.RunUntil(7 * time.Second)
+	if s.Now() != 7*time.Second {
+		t.Errorf("Now() = %v, want 7s", s.Now())
+	}
+	if s.Pending() != 1 {
+		t.Errorf("Pending() = %d, want 1", s.Pending())
+	}
+
+	s.Run()
+	if s.Now() != 8*time.Second {
+		t.Errorf("Now() = %v, want 8s", s.Now())
+	}
+}
+
+func TestStop(t *testing.T) {
+	s := New()
+	count := 0
+	for i := range 5 {
+		s.Schedule(time.Duration(i)*time.Second, func(s *Simulator) {
+			count++
+			if count == 2 {
+				s.Stop()
+			}
+		})
+	}
+	s.RunUntil(10 * time.Second)
+	if count != 2 {
+		t.Errorf("executed %d events, want 2", count)
+	}
+	if s.Now() != time.Second {
+		t.Errorf("Now() = %v, want 1s", s.Now())
+	}
+	s.Run()
+	if count != 5 {
+		t.Errorf("executed %d events, want 5", count)
+	}
+}
+
+func ExampleSimulator() {
+	s := New()
+	// a single server queue with deterministic arrivals every 3s and 5s service time
+	busyUntil := time.Duration(0)
+	for i := range 3 {
+		s.Schedule(time.Duration(i)*3*time.Second, func(s *Simulator) {
+			start := max(s.Now(), busyUntil)
+			busyUntil = start + 5*time.Second
+			s.Schedule(busyUntil, func(s *Simulator) {
+				fmt.Printf("job %d arrived at %v, done at %v\n", i, time.Duration(i)*3*time.Second, s.Now())
+			})
+		})
+	}
+	s.Run()
+	// Output:
+	// job 0 arrived at 0s, done at 5s
+	// job 1 arrived at 3s, done at 10s
+	// job 2 arrived at 6s, done at 15s
+}
@@ -0,0 +1,108 @@
+package externalsort
+
+import (
+	"bufio"
+	"encoding/gob"
+	"io"
+	"strings"
+)
+
+// Encoder writes records of type T to an underlying stream.
+type Encoder[T any] interface {
+	Encode(x T) error
+}
+
+// Decoder reads records of type T from an underlying stream.
+// Decode returns io.EOF when there are no more records.
+type Decoder[T any] interface {
+	Decode() (T, error)
+}
+
+// Codec serialises records to the temporary run files and to the sorted output,
+// and deserialises them from the input and the run files.
+//
+// The Sorter buffers the readers and writers it passes to the codec,
+// so codecs do not need to add buffering of their own.
+type Codec[T any] interface {
+	NewEncoder(w io.Writer) Encoder[T]
+	NewDecoder(r io.Reader) Decoder[T]
+}
+
+// LinesCodec is a Codec for newline separated text, such as log files.
+// Every record is a single line without its trailing newline.
+// The last line of the input does not need to be terminated by a newline.
+type LinesCodec struct{}
+
+// NewEncoder returns an encoder that writes every record followed by a newline.
+func (LinesCodec) NewEncoder(w io.Writer) Encoder[string] {
+	return &linesEncoder{w: w}
+}
+
+// NewDecoder returns a decoder that reads the stream line by line.
+func (LinesCodec) NewDecoder(r io.Reader) Decoder[string] {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	return &linesDecoder{r: br}
+}
+
+type linesEncoder struct {
+	w io.Writer
+}
+
+func (e *linesEncoder) Encode(x string) error {
+	if _, err := io.WriteString(e.w, x); err != nil {
+		return err
+	}
+	_, err := io.WriteString(e.w, "\n")
+	return err
+}
+
+type linesDecoder struct {
+	r *bufio.Reader
+}
+
+func (d *linesDecoder) Decode() (string, error) {
+	line, err := d.r.ReadString('\n')
+	if err == io.EOF && line != "" {
+		// the last line is not terminated by a newline
+		return line, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(line, "\n"), nil
+}
+
+// GobCodec is a Codec that serialises records with encoding/gob.
+// It works for any type supported by encoding/gob.
+type GobCodec[T any] struct{}
+
+// NewEncoder returns an encoder backed by a gob.Encoder.
+func (GobCodec[T]) NewEncoder(w io.Writer) Encoder[T] {
+	return &gobEncoder[T]{enc: gob.NewEncoder(w)}
+}
+
+// NewDecoder returns a decoder backed by a gob.Decoder.
+func (GobCodec[T]) NewDecoder(r io.Reader) Decoder[T] {
+	return &gobDecoder[T]{dec: gob.NewDecoder(r)}
+}
+
+type gobEncoder[T any] struct {
+	enc *gob.Encoder
+}
+
+func (e *gobEncoder[T]) Encode(x T) error {
+	return e.enc.Encode(x)
+}
+
+type gobDecoder[T any] struct {
+	dec *gob.Decoder
+}
+
+func (d *gobDecoder[T]) Decode() (T, error) {
+	var x T
+	err := d.dec.Decode(&x)
+	return x, err
+}
@@ -0,0 +1,69 @@
+package externalsort
+
+import (
+	"bytes"
+	"io"
+	"slices"
+	"testing"
+)
+
+func TestLinesCodec(t *testing.T) {
+	var buf bytes.Buffer
+	enc := LinesCodec{}.NewEncoder(&buf)
+	lines := []string{"first", "", "third line"}
+	for _, line := range lines {
+		if err := enc.Encode(line); err != nil {
+			t.Fatalf("Encode(%q) error = %v", line, err)
+		}
+	}
+	if buf.String() != "first\n\nthird line\n" {
+		t.Errorf("encoded = %q, want %q", buf.String(), "first\n\nthird line\n")
+	}
+
+	dec := LinesCodec{}.NewDecoder(&buf)
+	var got []string
+	for {
+		line, err := dec.Decode()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+		got = append(got, line)
+	}
+	if !slices.Equal(got, lines) {
+		t.Errorf("decoded = %q, want %q", got, lines)
+	}
+}
+
+func TestGobCodec(t *testing.T) {
+	type entry struct {
+		Key   string
+		Value int
+	}
+	var buf bytes.Buffer
+	enc := GobCodec[entry]{}.NewEncoder(&buf)
+	entries := []entry{{"a", 1}, {"b", 2}, {"c", 3}}
+	for _, e := range entries {
+		if err := enc.Encode(e); err != nil {
+			t.Fatalf("Encode(%v) error = %v", e, err)
+		}
+	}
+
+	dec := GobCodec[entry]{}.NewDecoder(&buf)
+	var got []entry
+	for {
+		e, err := dec.Decode()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+		got = append(got, e)
+	}
+	if !slices.Equal(got, entries) {
+		t.Errorf("decoded = %v, want %v", got, entries)
+	}
+}
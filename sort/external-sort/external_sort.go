@@ -0,0 +1,331 @@
+// Package externalsort implements sorting of data sets that do not fit in memory.
+//
+// The input is split into sorted runs with replacement selection: a binary heap holding
+// as many records as the memory budget allows emits the smallest record that can still
+// extend the current run, and records that are too small are deferred to the next run.
+// On random input this produces runs about twice as long as the memory budget, and
+// already sorted input produces a single run. The runs are spilled to temporary files
+// and merged back with a heap based k-way merge.
+package externalsort
+
+import (
+	"bufio"
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	binaryheap "github.com/GrzegorzMika/data-structures/heap/binary-heap"
+)
+
+// defaultFanIn is the default maximum number of runs merged at once.
+const defaultFanIn = 64
+
+// Option configures a Sorter.
+type Option[T any] func(*Sorter[T])
+
+// WithSizeFunc sets the function used to estimate the memory occupied by a record.
+// By default every record counts as 1, so the memory budget is the number of records held in memory.
+func WithSizeFunc[T any](size func(T) int) Option[T] {
+	return func(s *Sorter[T]) {
+		s.size = size
+	}
+}
+
+// WithTempDir sets the directory in which the temporary run files are created.
+// By default the directory returned by os.TempDir is used.
+func WithTempDir[T any](dir string) Option[T] {
+	return func(s *Sorter[T]) {
+		s.tempDir = dir
+	}
+}
+
+// WithFanIn sets the maximum number of runs merged at once.
+// If there are more runs, they are merged in several passes.
+// Every merged run keeps one record and one read buffer in memory.
+// It panics if n is smaller than 2.
+func WithFanIn[T any](n int) Option[T] {
+	if n < 2 {
+		panic("externalsort: fan-in must be at least 2")
+	}
+	return func(s *Sorter[T]) {
+		s.fanIn = n
+	}
+}
+
+type Sorter[T any] struct {
+	codec   Codec[T]
+	cmp     func(a, b T) int
+	budget  int
+	size    func(T) int
+	tempDir string
+	fanIn   int
+}
+
+// NewSorter creates a new instance of Sorter.
+// The records are read and written with the codec and sorted in ascending order according to cmp.
+// The memoryBudget limits the total size of the records held in memory while generating runs,
+// as measured by the size function set with WithSizeFunc.
+// At least one record is always held in memory, regardless of its size.
+//
+// It panics if memoryBudget is smaller than 1.
+//
+// Example usage:
+//
+//	s := externalsort.NewSorter[string](externalsort.LinesCodec{}, strings.Compare, 64<<20,
+//		externalsort.WithSizeFunc(func(s string) int { return len(s) }))
+//	err := s.Sort(dst, src)
+func NewSorter[T any](codec Codec[T], cmp func(a, b T) int, memoryBudget int, opts ...Option[T]) *Sorter[T] {
+	if memoryBudget < 1 {
+		panic("externalsort: memory budget must be positive")
+	}
+	s := &Sorter[T]{
+		codec:  codec,
+		cmp:    cmp,
+		budget: memoryBudget,
+		size:   func(T) int { return 1 },
+		fanIn:  defaultFanIn,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// SortLines sorts the lines read from src and writes them to dst.
+// The memoryBudget is the number of bytes of lines held in memory while generating runs.
+func SortLines(dst io.Writer, src io.Reader, memoryBudget int) error {
+	s := NewSorter[string](LinesCodec{}, strings.Compare, memoryBudget,
+		WithSizeFunc(func(s string) int { return len(s) }))
+	return s.Sort(dst, src)
+}
+
+// Sort reads all records from src, sorts them and writes them to dst.
+// The temporary files are removed before Sort returns, even if it fails.
+//
+// The time complexity of this method is O(n log n), where n is the number of records,
+// and the memory usage is bounded by the memory budget and the fan-in.
+func (s *Sorter[T]) Sort(dst io.Writer, src io.Reader) (err error) {
+	dir, err := os.MkdirTemp(s.tempDir, "external-sort-")
+	if err != nil {
+		return fmt.Errorf("externalsort: creating temporary directory: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, os.RemoveAll(dir))
+	}()
+
+	runs, err := s.generateRuns(dir, src)
+	if err != nil {
+		return err
+	}
+	for len(runs) > s.fanIn {
+		merged, err := s.mergeToRun(dir, runs[:s.fanIn])
+		if err != nil {
+			return err
+		}
+		runs = append(runs[s.fanIn:], merged)
+	}
+
+	bw := bufio.NewWriter(dst)
+	if err := s.merge(s.codec.NewEncoder(bw), runs); err != nil {
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("externalsort: writing output: %w", err)
+	}
+	return nil
+}
+
+// record is a record waiting in the replacement selection heap.
+type record[T any] struct {
+	run int
+	x   T
+}
+
+// generateRuns splits the input into sorted runs with replacement selection
+// and returns the paths of the run files.
+func (s *Sorter[T]) generateRuns(dir string, src io.Reader) ([]string, error) {
+	// the heap keeps the smallest record of the earliest run at the top
+	h := binaryheap.NewBinaryHeapFunc(func(a, b record[T]) int {
+		if a.run != b.run {
+			return cmp.Compare(b.run, a.run)
+		}
+		return s.cmp(b.x, a.x)
+	})
+	dec := s.codec.NewDecoder(bufio.NewReader(src))
+	eof := false
+	used := 0
+	// read the next record into the heap, assigning it to the given run
+	// unless it is smaller than the last record written to that run
+	fill := func(run int, last *T) error {
+		for !eof && (used < s.budget || h.IsEmpty()) {
+			x, err := dec.Decode()
+			if err == io.EOF {
+				eof = true
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("externalsort: reading input: %w", err)
+			}
+			r := record[T]{run: run, x: x}
+			if last != nil && s.cmp(x, *last) < 0 {
+				r.run++
+			}
+			h.Push(r)
+			used += s.size(x)
+		}
+		return nil
+	}
+
+	if err := fill(0, nil); err != nil {
+		return nil, err
+	}
+	var (
+		runs []string
+		w    *runWriter[T]
+	)
+	for !h.IsEmpty() {
+		r, _ := h.Pop()
+		used -= s.size(r.x)
+		if w == nil || r.run != len(runs)-1 {
+			if w != nil {
+				if err := w.Close(); err != nil {
+					return nil, err
+				}
+			}
+			var err error
+			if w, err = s.newRunWriter(dir); err != nil {
+				return nil, err
+			}
+			runs = append(runs, w.f.Name())
+		}
+		if err := w.Encode(r.x); err != nil {
+			_ = w.Close()
+			return nil, err
+		}
+		if err := fill(r.run, &r.x); err != nil {
+			_ = w.Close()
+			return nil, err
+		}
+	}
+	if w != nil {
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+	}
+	return runs, nil
+}
+
+// cursor is the current record of a run taking part in a merge.
+type cursor[T any] struct {
+	x   T
+	run int
+}
+
+// merge performs a k-way merge of the runs and writes the records to enc.
+// Records that compare equal are written in the order of their runs.
+func (s *Sorter[T]) merge(enc Encoder[T], runs []string) error {
+	decoders := make([]Decoder[T], len(runs))
+	for i, path := range runs {
+		f, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("externalsort: opening run: %w", err)
+		}
+		defer f.Close()
+		decoders[i] = s.codec.NewDecoder(bufio.NewReader(f))
+	}
+
+	// the heap keeps the smallest current record at the top
+	h := binaryheap.NewBinaryHeapFuncWithCapacity(len(runs), func(a, b cursor[T]) int {
+		if c := s.cmp(b.x, a.x); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.run, a.run)
+	})
+	advance := func(run int) error {
+		x, err := decoders[run].Decode()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("externalsort: reading run: %w", err)
+		}
+		h.Push(cursor[T]{x: x, run: run})
+		return nil
+	}
+
+	for i := range decoders {
+		if err := advance(i); err != nil {
+			return err
+		}
+	}
+	for !h.IsEmpty() {
+		c, _ := h.Pop()
+		if err := enc.Encode(c.x); err != nil {
+			return fmt.Errorf("externalsort: writing record: %w", err)
+		}
+		if err := advance(c.run); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeToRun merges the runs into a new run file and removes the merged runs.
+func (s *Sorter[T]) mergeToRun(dir string, runs []string) (string, error) {
+	w, err := s.newRunWriter(dir)
+	if err != nil {
+		return "", err
+	}
+	if err := s.merge(w.enc, runs); err != nil {
+		_ = w.Close()
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	for _, path := range runs {
+		if err := os.Remove(path); err != nil {
+			return "", fmt.Errorf("externalsort: removing run: %w", err)
+		}
+	}
+	return w.f.Name(), nil
+}
+
+// runWriter writes records to a temporary run file.
+type runWriter[T any] struct {
+	f   *os.File
+	bw  *bufio.Writer
+	enc Encoder[T]
+}
+
+func (s *Sorter[T]) newRunWriter(dir string) (*runWriter[T], error) {
+	f, err := os.CreateTemp(dir, "run-")
+	if err != nil {
+		return nil, fmt.Errorf("externalsort: creating run: %w", err)
+	}
+	bw := bufio.NewWriter(f)
+	return &runWriter[T]{
+		f:   f,
+		bw:  bw,
+		enc: s.codec.NewEncoder(bw),
+	}, nil
+}
+
+func (w *runWriter[T]) Encode(x T) error {
+	if err := w.enc.Encode(x); err != nil {
+		return fmt.Errorf("externalsort: writing run: %w", err)
+	}
+	return nil
+}
+
+func (w *runWriter[T]) Close() error {
+	err := w.bw.Flush()
+	err = errors.Join(err, w.f.Close())
+	if err != nil {
+		return fmt.Errorf("externalsort: writing run: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package externalsort
+
+import (
+	"bytes"
+	"cmp"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNewSorterPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("The code did not panic")
+		}
+	}()
+	NewSorter[string](LinesCodec{}, strings.Compare, 0)
+}
+
+func TestSortLines(t *testing.T) {
+	type testCase struct {
+		name  string
+		input string
+		want  string
+	}
+
+	testCases := []testCase{
+		{
+			name:  "empty",
+			input: "",
+			want:  "",
+		},
+		{
+			name:  "one line",
+			input: "a\n",
+			want:  "a\n",
+		},
+		{
+			name:  "missing trailing newline",
+			input: "b\na",
+			want:  "a\nb\n",
+		},
+		{
+			name:  "multiple lines with duplicates",
+			input: "delta\nalpha\ncharlie\nalpha\nbravo\necho\n",
+			want:  "alpha\nalpha\nbravo\ncharlie\ndelta\necho\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := SortLines(&out, strings.NewReader(tc.input), 8); err != nil {
+				t.Fatalf("SortLines() error = %v", err)
+			}
+			if out.String() != tc.want {
+				t.Errorf("SortLines() = %q, want %q", out.String(), tc.want)
+			}
+		})
+	}
+}
+
+func TestSorterRandomInput(t *testing.T) {
+	for _, budget := range []int{1, 7, 100, 10000} {
+		t.Run(fmt.Sprintf("budget=%d", budget), func(t *testing.T) {
+			r := rand.New(rand.NewPCG(1, uint64(budget)))
+			xs := make([]int, 5000)
+			var in bytes.Buffer
+			enc := GobCodec[int]{}.NewEncoder(&in)
+			for i := range xs {
+				xs[i] = r.IntN(1000)
+				if err := enc.Encode(xs[i]); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var out bytes.Buffer
+			s := NewSorter[int](GobCodec[int]{}, cmp.Compare[int], budget, WithFanIn[int](4), WithTempDir[int](t.TempDir()))
+			if err := s.Sort(&out, &in); err != nil {
+				t.Fatalf("Sort() error = %v", err)
+			}
+
+			dec := GobCodec[int]{}.NewDecoder(&out)
+			var got []int
+			for {
+				x, err := dec.Decode()
+				if err != nil {
+					break
+				}
+				got = append(got, x)
+			}
+			slices.Sort(xs)
+			if !slices.Equal(got, xs) {
+				t.Errorf("Sort() returned %d records, want %d sorted records", len(got), len(xs))
+			}
+		})
+	}
+}
+
+func TestSorterRuns(t *testing.T) {
+	type testCase struct {
+		name  string
+		input []int
+		runs  int
+	}
+
+	testCases := []testCase{
+		{
+			name:  "empty",
+			input: nil,
+			runs:  0,
+		},
+		{
+			name:  "sorted input produces a single run",
+			input: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			runs:  1,
+		},
+		{
+			name:  "reversed input produces runs of the budget size",
+			input: []int{10, 9, 8, 7, 6, 5, 4, 3, 2, 1},
+			runs:  4,
+		},
+		{
+			name:  "replacement selection extends runs beyond the budget",
+			input: []int{5, 1, 6, 2, 7, 8, 9},
+			runs:  1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var in bytes.Buffer
+			enc := GobCodec[int]{}.NewEncoder(&in)
+			for _, x := range tc.input {
+				if err := enc.Encode(x); err != nil {
+					t.Fatal(err)
+				}
+			}
+			s := NewSorter[int](GobCodec[int]{}, cmp.Compare[int], 3)
+			dir := t.TempDir()
+			runs, err := s.generateRuns(dir, &in)
+			if err != nil {
+				t.Fatalf("generateRuns() error = %v", err)
+			}
+			if len(runs) != tc.runs {
+				t.Errorf("generateRuns() produced %d runs, want %d", len(runs), tc.runs)
+			}
+		})
+	}
+}
+
+func TestSorterRemovesTemporaryFiles(t *testing.T) {
+	dir := t.TempDir()
+	s := NewSorter[string](LinesCodec{}, strings.Compare, 2, WithTempDir[string](dir), WithFanIn[string](2))
+	var out bytes.Buffer
+	if err := s.Sort(&out, strings.NewReader("e\nd\nc\nb\na\n")); err != nil {
+		t.Fatalf("Sort() error = %v", err)
+	}
+	if out.String() != "a\nb\nc\nd\ne\n" {
+		t.Errorf("Sort() = %q, want %q", out.String(), "a\nb\nc\nd\ne\n")
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("temporary directory contains %d entries, want 0", len(entries))
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("boom")
+}
+
+func TestSorterPropagatesErrors(t *testing.T) {
+	var out bytes.Buffer
+	err := SortLines(&out, failingReader{}, 10)
+	if err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Errorf("SortLines() error = %v, want error containing %q", err, "boom")
+	}
+}
+
+func BenchmarkSortLines(b *testing.B) {
+	r := rand.New(rand.NewPCG(1, 2))
+	var in bytes.Buffer
+	for range 10000 {
+		fmt.Fprintf(&in, "%08d\n", r.IntN(1_000_000))
+	}
+	input := in.Bytes()
+	b.ResetTimer()
+	for range b.N {
+		var out bytes.Buffer
+		if err := SortLines(&out, bytes.NewReader(input), 16<<10); err != nil {
+			b.Fatal(err)
+		}
+	}
+}